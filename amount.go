@@ -0,0 +1,58 @@
+package privatebtc
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// Amount is an amount of bitcoin in satoshis.
+// The bitcoin RPC API represents amounts as BTC decimals, the RPC clients convert
+// them to and from Amount so that no float arithmetic happens on amounts.
+type Amount int64
+
+const (
+	// Satoshi is the smallest amount of bitcoin.
+	Satoshi Amount = 1
+
+	// BTC is one bitcoin.
+	BTC Amount = 1e8
+)
+
+// AmountFromBTC converts a BTC value, as represented by the bitcoin RPC API,
+// to an Amount, rounding to the nearest satoshi.
+func AmountFromBTC(btc float64) (Amount, error) {
+	if math.IsNaN(btc) || math.IsInf(btc, 0) {
+		return 0, fmt.Errorf("%v BTC: %w", btc, ErrInvalidAmount)
+	}
+
+	return Amount(math.Round(btc * float64(BTC))), nil
+}
+
+// ToBTC returns the amount in BTC, as represented by the bitcoin RPC API.
+func (a Amount) ToBTC() float64 {
+	return float64(a) / float64(BTC)
+}
+
+// String returns the amount in BTC with 8 decimals, e.g. "0.00100000 BTC".
+func (a Amount) String() string {
+	return strconv.FormatFloat(a.ToBTC(), 'f', 8, 64) + " BTC"
+}
+
+// FeeRate is a transaction fee rate in satoshis per virtual byte.
+type FeeRate float64
+
+// NewFeeRate returns the fee rate of a transaction paying the given fee for the
+// given virtual size.
+func NewFeeRate(fee Amount, vsize int) FeeRate {
+	if vsize == 0 {
+		return 0
+	}
+
+	return FeeRate(float64(fee) / float64(vsize))
+}
+
+// String returns the fee rate with 2 decimals, e.g. "1.50 sat/vB".
+func (r FeeRate) String() string {
+	return strconv.FormatFloat(float64(r), 'f', 2, 64) + " sat/vB"
+}
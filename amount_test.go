@@ -0,0 +1,106 @@
+package privatebtc_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/adrianbrad/privatebtc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAmount(t *testing.T) {
+	t.Parallel()
+
+	t.Run("AmountFromBTC", func(t *testing.T) {
+		t.Parallel()
+
+		tests := map[string]struct {
+			btc            float64
+			expectedAmount privatebtc.Amount
+			errorAssertion require.ErrorAssertionFunc
+		}{
+			"OneBTC": {
+				btc:            1,
+				expectedAmount: privatebtc.BTC,
+				errorAssertion: require.NoError,
+			},
+			"OneSatoshi": {
+				btc:            0.00000001,
+				expectedAmount: privatebtc.Satoshi,
+				errorAssertion: require.NoError,
+			},
+			"RoundsToNearestSatoshi": {
+				// 0.1 + 0.2 is 0.30000000000000004 in float64.
+				btc:            0.1 + 0.2,
+				expectedAmount: 30_000_000,
+				errorAssertion: require.NoError,
+			},
+			"Negative": {
+				btc:            -0.5,
+				expectedAmount: -50_000_000,
+				errorAssertion: require.NoError,
+			},
+			"NaN": {
+				btc:            math.NaN(),
+				expectedAmount: 0,
+				errorAssertion: func(t require.TestingT, err error, i ...any) {
+					require.ErrorIs(t, err, privatebtc.ErrInvalidAmount, i...)
+				},
+			},
+			"Inf": {
+				btc:            math.Inf(1),
+				expectedAmount: 0,
+				errorAssertion: func(t require.TestingT, err error, i ...any) {
+					require.ErrorIs(t, err, privatebtc.ErrInvalidAmount, i...)
+				},
+			},
+		}
+
+		for name, test := range tests {
+			test := test
+
+			t.Run(name, func(t *testing.T) {
+				t.Parallel()
+
+				amount, err := privatebtc.AmountFromBTC(test.btc)
+				test.errorAssertion(t, err)
+
+				require.Equal(t, test.expectedAmount, amount)
+			})
+		}
+	})
+
+	t.Run("ToBTC", func(t *testing.T) {
+		t.Parallel()
+
+		require.Equal(t, 0.001, (100_000 * privatebtc.Satoshi).ToBTC())
+	})
+
+	t.Run("String", func(t *testing.T) {
+		t.Parallel()
+
+		require.Equal(t, "0.00100000 BTC", (100_000 * privatebtc.Satoshi).String())
+		require.Equal(t, "21000000.00000000 BTC", (21_000_000 * privatebtc.BTC).String())
+	})
+
+	t.Run("GetTransactionFee", func(t *testing.T) {
+		t.Parallel()
+
+		tx := privatebtc.Transaction{
+			Vout: []privatebtc.TransactionVout{
+				{Value: 10_000_000},
+				{Value: 89_990_000},
+			},
+		}
+
+		require.Equal(t, 10_000*privatebtc.Satoshi, tx.GetTransactionFee(privatebtc.BTC))
+	})
+
+	t.Run("FeeRate", func(t *testing.T) {
+		t.Parallel()
+
+		require.Equal(t, privatebtc.FeeRate(10), privatebtc.NewFeeRate(1410, 141))
+		require.Equal(t, privatebtc.FeeRate(0), privatebtc.NewFeeRate(1410, 0))
+		require.Equal(t, "1.50 sat/vB", privatebtc.FeeRate(1.5).String())
+	})
+}
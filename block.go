@@ -0,0 +1,91 @@
+package privatebtc
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// BlockHeader represents a BTC block header.
+type BlockHeader struct {
+	Hash string
+	// Height is the height of the block in its chain.
+	Height int
+	// Confirmations is the number of confirmations of the block,
+	// -1 if the block is not on the active chain, e.g. an orphaned block.
+	Confirmations     int
+	PreviousBlockHash string
+	// NextBlockHash is the hash of the next block on the active chain,
+	// empty for the chain tip and for the blocks which are not on the active chain.
+	NextBlockHash string
+	MerkleRoot    string
+	Time          time.Time
+}
+
+// Block represents a BTC block along with its transactions.
+type Block struct {
+	BlockHeader
+	// Transactions are the block transactions, the first one being the coinbase.
+	Transactions []Transaction
+}
+
+// TxIDs returns the IDs of the block transactions.
+func (b Block) TxIDs() []string {
+	txIDs := make([]string, len(b.Transactions))
+
+	for i := range b.Transactions {
+		txIDs[i] = b.Transactions[i].TxID
+	}
+
+	return txIDs
+}
+
+// ChainTipStatus is the status of a chain tip, as reported by the getchaintips RPC.
+type ChainTipStatus string
+
+// The chain tip statuses.
+const (
+	// ChainTipStatusActive is the tip of the active chain.
+	ChainTipStatusActive ChainTipStatus = "active"
+	// ChainTipStatusValidFork is the tip of a fully validated branch which is not
+	// part of the active chain, e.g. the tip of a chain orphaned by a reorg.
+	ChainTipStatusValidFork ChainTipStatus = "valid-fork"
+	// ChainTipStatusValidHeaders is the tip of a branch whose blocks are all
+	// available but were never fully validated.
+	ChainTipStatusValidHeaders ChainTipStatus = "valid-headers"
+	// ChainTipStatusHeadersOnly is the tip of a branch whose blocks are not all available.
+	ChainTipStatusHeadersOnly ChainTipStatus = "headers-only"
+	// ChainTipStatusInvalid is the tip of a branch containing at least one invalid block.
+	ChainTipStatusInvalid ChainTipStatus = "invalid"
+)
+
+// ChainTip represents the tip of a chain known by a node.
+type ChainTip struct {
+	Hash   string
+	Height int
+	// BranchLen is the length of the branch connecting the tip to the active chain,
+	// 0 for the active chain tip.
+	BranchLen int
+	Status    ChainTipStatus
+}
+
+// ForkBlocks returns the blocks of the branch ending in the given chain tip which are
+// not part of the active chain, ordered from the fork point up to the tip.
+// For a valid-fork tip left behind by a chain reorg these are the orphaned blocks.
+func (n Node) ForkBlocks(ctx context.Context, tip ChainTip) ([]*Block, error) {
+	blocks := make([]*Block, tip.BranchLen)
+
+	blockHash := tip.Hash
+
+	for i := tip.BranchLen - 1; i >= 0; i-- {
+		block, err := n.RPCClient().GetBlock(ctx, blockHash)
+		if err != nil {
+			return nil, fmt.Errorf("get block %q: %w", blockHash, err)
+		}
+
+		blocks[i] = block
+		blockHash = block.PreviousBlockHash
+	}
+
+	return blocks, nil
+}
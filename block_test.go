@@ -0,0 +1,119 @@
+package privatebtc_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/adrianbrad/privatebtc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodeForkBlocks(t *testing.T) {
+	t.Parallel()
+
+	// fork2 -> fork1 -> common, the fork is orphaned by a longer active chain.
+	chain := map[string]*privatebtc.Block{
+		"fork2": {
+			BlockHeader: privatebtc.BlockHeader{
+				Hash:              "fork2",
+				Height:            12,
+				Confirmations:     -1,
+				PreviousBlockHash: "fork1",
+			},
+			Transactions: []privatebtc.Transaction{{TxID: "coinbase2"}, {TxID: "tx"}},
+		},
+		"fork1": {
+			BlockHeader: privatebtc.BlockHeader{
+				Hash:              "fork1",
+				Height:            11,
+				Confirmations:     -1,
+				PreviousBlockHash: "common",
+			},
+			Transactions: []privatebtc.Transaction{{TxID: "coinbase1"}},
+		},
+	}
+
+	forkTip := privatebtc.ChainTip{
+		Hash:      "fork2",
+		Height:    12,
+		BranchLen: 2,
+		Status:    privatebtc.ChainTipStatusValidFork,
+	}
+
+	errGetBlock := errors.New("get block error")
+
+	tests := map[string]struct {
+		tip            privatebtc.ChainTip
+		getBlockErr    error
+		expectedHashes []string
+		expectedError  error
+	}{
+		"ValidFork": {
+			tip:            forkTip,
+			expectedHashes: []string{"fork1", "fork2"},
+		},
+		"Active": {
+			tip:            privatebtc.ChainTip{Hash: "active", Status: privatebtc.ChainTipStatusActive},
+			expectedHashes: []string{},
+		},
+		"GetBlockError": {
+			tip:           forkTip,
+			getBlockErr:   errGetBlock,
+			expectedError: errGetBlock,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := require.New(t)
+
+			rpcClient := newChainReorgSuccessRPCClient(nil)
+
+			rpcClient.GetBlockFunc = func(_ context.Context, blockHash string) (*privatebtc.Block, error) {
+				if test.getBlockErr != nil {
+					return nil, test.getBlockErr
+				}
+
+				return chain[blockHash], nil
+			}
+
+			pn, err := privatebtc.NewPrivateNetwork(
+				newPrivateNetworkStartSuccessDockerService(
+					newPrivateNetworkStartSuccessNodeHandler(),
+					newPrivateNetworkStartSuccessNodeHandler(),
+				),
+				newPrivateNetworkStartSuccessRPCClientFactory(rpcClient),
+				2,
+			)
+			req.NoError(err)
+
+			req.NoError(pn.Start(context.Background()))
+
+			blocks, err := pn.Nodes()[0].ForkBlocks(context.Background(), test.tip)
+			req.ErrorIs(err, test.expectedError)
+
+			if test.expectedError != nil {
+				return
+			}
+
+			hashes := make([]string, len(blocks))
+
+			for i := range blocks {
+				hashes[i] = blocks[i].Hash
+			}
+
+			req.Equal(test.expectedHashes, hashes)
+		})
+	}
+
+	t.Run("TxIDs", func(t *testing.T) {
+		t.Parallel()
+
+		require.Equal(t, []string{"coinbase2", "tx"}, chain["fork2"].TxIDs())
+	})
+}
@@ -0,0 +1,70 @@
+package btcsuite
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/btcsuite/btcd/rpcclient"
+)
+
+// The rpcclient calls do not accept a context, and an rpcclient in HTTP POST mode
+// sends its requests one at a time, so a single stalled request would delay every
+// later call sent through the same rpcclient.
+// Every call is therefore sent through its own rpcclient, started asynchronously
+// and awaited until the context is done.
+// Once the context is done the call returns the context error right away, the
+// request keeps running in the background until the node answers, and its side
+// effects still take place, but it no longer delays the later calls.
+
+// call runs the given RPC call using a new rpcclient, returning early if the context
+// is done. The call is not started at all if the context is already done.
+func call[T any](ctx context.Context, c RPCClient, rpcCall func(*rpcclient.Client) (T, error)) (T, error) {
+	var zero T
+
+	if err := ctx.Err(); err != nil {
+		return zero, err
+	}
+
+	// the rpcclient keeps a pointer to its config.
+	config := c.config
+
+	client, err := rpcclient.New(&config, nil)
+	if err != nil {
+		return zero, fmt.Errorf("create rpc client: %w", err)
+	}
+
+	type result struct {
+		value T
+		err   error
+	}
+
+	// buffered, so that the call goroutine can exit once the request completes,
+	// even if nobody is waiting for its result anymore.
+	resCh := make(chan result, 1)
+
+	go func() {
+		// stops the rpcclient goroutine once the request completes.
+		defer client.Shutdown()
+
+		value, err := rpcCall(client)
+		resCh <- result{value: value, err: err}
+	}()
+
+	select {
+	case <-ctx.Done():
+		return zero, ctx.Err()
+
+	case res := <-resCh:
+		return res.value, res.err
+	}
+}
+
+// exec runs the given RPC call which has no result using a new rpcclient,
+// returning early if the context is done.
+func exec(ctx context.Context, c RPCClient, rpcCall func(*rpcclient.Client) error) error {
+	_, err := call(ctx, c, func(client *rpcclient.Client) (struct{}, error) {
+		return struct{}{}, rpcCall(client)
+	})
+
+	return err
+}
@@ -1,29 +1,42 @@
 package btcsuite
 
 import (
+	"bytes"
 	"context"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/adrianbrad/privatebtc"
 	"github.com/btcsuite/btcd/btcjson"
 	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/rpcclient"
-	"golang.org/x/sync/errgroup"
+	"github.com/btcsuite/btcd/wire"
 )
 
 var _ privatebtc.RPCClient = (*RPCClient)(nil)
 
 // RPCClient is an RPC client for a BTC node.
+// Every call is sent through its own rpcclient, see call.
 type RPCClient struct {
-	client *rpcclient.Client
+	// config is the connection config of the node, including the wallet path of
+	// a client scoped to a wallet.
+	config rpcclient.ConnConfig
+	// host is the host of the node, without the wallet path.
+	host string
 }
 
 // GetNewAddress generates a new BTC address.
-func (c RPCClient) GetNewAddress(_ context.Context, label string) (string, error) {
-	addr, err := c.client.GetNewAddress(label)
+func (c RPCClient) GetNewAddress(ctx context.Context, label string) (string, error) {
+	addr, err := call(ctx, c, func(client *rpcclient.Client) (btcutil.Address, error) {
+		return client.GetNewAddressAsync(label).Receive()
+	})
 	if err != nil {
 		return "", err
 	}
@@ -32,8 +45,10 @@ func (c RPCClient) GetNewAddress(_ context.Context, label string) (string, error
 }
 
 // GetConnectionCount returns the number of connections to other nodes.
-func (c RPCClient) GetConnectionCount(context.Context) (int, error) {
-	count, err := c.client.GetConnectionCount()
+func (c RPCClient) GetConnectionCount(ctx context.Context) (int, error) {
+	count, err := call(ctx, c, func(client *rpcclient.Client) (int64, error) {
+		return client.GetConnectionCountAsync().Receive()
+	})
 	if err != nil {
 		return 0, fmt.Errorf("get connection count: %w", err)
 	}
@@ -42,8 +57,10 @@ func (c RPCClient) GetConnectionCount(context.Context) (int, error) {
 }
 
 // GetRawMempool returns the hashes of all transactions in the mempool.
-func (c RPCClient) GetRawMempool(context.Context) ([]string, error) {
-	hashes, err := c.client.GetRawMempool()
+func (c RPCClient) GetRawMempool(ctx context.Context) ([]string, error) {
+	hashes, err := call(ctx, c, func(client *rpcclient.Client) ([]*chainhash.Hash, error) {
+		return client.GetRawMempoolAsync().Receive()
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -56,9 +73,88 @@ func (c RPCClient) GetRawMempool(context.Context) ([]string, error) {
 	return hs, nil
 }
 
+// GetMempoolEntry returns the mempool entry of the transaction with the given hash.
+func (c RPCClient) GetMempoolEntry(ctx context.Context, txHash string) (*privatebtc.MempoolEntry, error) {
+	params, err := rawParams(txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.rawRequest(ctx, "getmempoolentry", params)
+	if err != nil {
+		if isTxNotInMempoolError(err) {
+			return nil, fmt.Errorf("tx %q: %w", txHash, privatebtc.ErrTxNotFoundInMempool)
+		}
+
+		return nil, fmt.Errorf("get mempool entry request: %w", err)
+	}
+
+	// the fee fields outside of fees were removed in bitcoind v23, the
+	// bip125-replaceable field is not part of btcjson.GetMempoolEntryResult.
+	// nolint: tagliatelle
+	var res struct {
+		VSize           int   `json:"vsize"`
+		Time            int64 `json:"time"`
+		AncestorCount   int   `json:"ancestorcount"`
+		AncestorSize    int   `json:"ancestorsize"`
+		DescendantCount int   `json:"descendantcount"`
+		DescendantSize  int   `json:"descendantsize"`
+		Fees            struct {
+			Base       float64 `json:"base"`
+			Ancestor   float64 `json:"ancestor"`
+			Descendant float64 `json:"descendant"`
+		} `json:"fees"`
+		BIP125Replaceable bool `json:"bip125-replaceable"`
+	}
+
+	if err := json.Unmarshal(resp, &res); err != nil {
+		return nil, fmt.Errorf("unmarshal response: %w", err)
+	}
+
+	entry := privatebtc.MempoolEntry{
+		VSize:             res.VSize,
+		Time:              time.Unix(res.Time, 0),
+		AncestorCount:     res.AncestorCount,
+		AncestorSize:      res.AncestorSize,
+		DescendantCount:   res.DescendantCount,
+		DescendantSize:    res.DescendantSize,
+		BIP125Replaceable: res.BIP125Replaceable,
+	}
+
+	for _, f := range []struct {
+		name  string
+		btc   float64
+		value *privatebtc.Amount
+	}{
+		{name: "base", btc: res.Fees.Base, value: &entry.Fee},
+		{name: "ancestor", btc: res.Fees.Ancestor, value: &entry.AncestorFees},
+		{name: "descendant", btc: res.Fees.Descendant, value: &entry.DescendantFees},
+	} {
+		if *f.value, err = privatebtc.AmountFromBTC(f.btc); err != nil {
+			return nil, fmt.Errorf("%s fee: %w", f.name, err)
+		}
+	}
+
+	return &entry, nil
+}
+
+// isTxNotInMempoolError reports whether the given error is the getmempoolentry or
+// getrawtransaction error for a transaction which is not in the mempool.
+func isTxNotInMempoolError(err error) bool {
+	var rpcErr *btcjson.RPCError
+
+	return errors.As(err, &rpcErr) &&
+		rpcErr.Code == btcjson.ErrRPCInvalidAddressOrKey &&
+		(rpcErr.Message == "Transaction not in mempool" ||
+			// the getrawtransaction message depends on the node version and on -txindex.
+			strings.HasPrefix(rpcErr.Message, "No such mempool"))
+}
+
 // GetBlockCount returns the current block count.
-func (c RPCClient) GetBlockCount(context.Context) (int, error) {
-	bc, err := c.client.GetBlockCount()
+func (c RPCClient) GetBlockCount(ctx context.Context) (int, error) {
+	bc, err := call(ctx, c, func(client *rpcclient.Client) (int64, error) {
+		return client.GetBlockCountAsync().Receive()
+	})
 	if err != nil {
 		return 0, err
 	}
@@ -74,8 +170,10 @@ func (e WalletWarningError) Error() string {
 }
 
 // CreateWallet creates a wallet with the given name.
-func (c RPCClient) CreateWallet(_ context.Context, walletName string) error {
-	res, err := c.client.CreateWallet(walletName)
+func (c RPCClient) CreateWallet(ctx context.Context, walletName string) error {
+	res, err := call(ctx, c, func(client *rpcclient.Client) (*btcjson.CreateWalletResult, error) {
+		return client.CreateWalletAsync(walletName).Receive()
+	})
 	if err != nil {
 		return fmt.Errorf("create wallet: %w", err)
 	}
@@ -87,15 +185,126 @@ func (c RPCClient) CreateWallet(_ context.Context, walletName string) error {
 	return nil
 }
 
+// LoadWallet loads the existing wallet with the given name.
+func (c RPCClient) LoadWallet(ctx context.Context, walletName string) error {
+	res, err := call(ctx, c, func(client *rpcclient.Client) (*btcjson.LoadWalletResult, error) {
+		return client.LoadWalletAsync(walletName).Receive()
+	})
+	if err != nil {
+		return fmt.Errorf("load wallet: %w", err)
+	}
+
+	if res.Warning != "" {
+		return WalletWarningError(res.Warning)
+	}
+
+	return nil
+}
+
+// UnloadWallet unloads the wallet with the given name.
+func (c RPCClient) UnloadWallet(ctx context.Context, walletName string) error {
+	params, err := rawParams(walletName)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.rawRequest(ctx, "unloadwallet", params)
+	if err != nil {
+		return fmt.Errorf("unload wallet request: %w", err)
+	}
+
+	var res struct {
+		Warning string `json:"warning"`
+	}
+
+	// older nodes return null, which leaves the result empty.
+	if err := json.Unmarshal(resp, &res); err != nil {
+		return fmt.Errorf("unmarshal response: %w", err)
+	}
+
+	if res.Warning != "" {
+		return WalletWarningError(res.Warning)
+	}
+
+	return nil
+}
+
+// ListWallets returns the names of the loaded wallets.
+func (c RPCClient) ListWallets(ctx context.Context) ([]string, error) {
+	resp, err := c.rawRequest(ctx, "listwallets", nil)
+	if err != nil {
+		return nil, fmt.Errorf("list wallets request: %w", err)
+	}
+
+	var wallets []string
+
+	if err := json.Unmarshal(resp, &wallets); err != nil {
+		return nil, fmt.Errorf("unmarshal response: %w", err)
+	}
+
+	return wallets, nil
+}
+
+// FlushChainState flushes the node chain state to disk.
+// The gettxoutsetinfo RPC flushes the chain state before computing the UTXO set
+// statistics, the coinstats index is not used so that the flush always happens.
+// Requires Bitcoin Core v22 or newer.
+func (c RPCClient) FlushChainState(ctx context.Context) error {
+	if _, err := c.rawRequest(
+		ctx,
+		"gettxoutsetinfo",
+		[]json.RawMessage{
+			json.RawMessage(strconv.Quote("none")),
+			json.RawMessage("null"),
+			json.RawMessage("false"),
+		},
+	); err != nil {
+		return fmt.Errorf("get tx out set info: %w", err)
+	}
+
+	return nil
+}
+
+// SetMockTime sets the node local time to the given time, the zero time resets
+// the node to the system time.
+func (c RPCClient) SetMockTime(ctx context.Context, t time.Time) error {
+	params, err := rawParams(mockTimestamp(t))
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.rawRequest(ctx, "setmocktime", params); err != nil {
+		return fmt.Errorf("set mock time request: %w", err)
+	}
+
+	return nil
+}
+
+// mockTimestamp returns the setmocktime timestamp of the given time, 0 disables
+// the mock time.
+func mockTimestamp(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+
+	return t.Unix()
+}
+
 // SendToAddress sends the given amount to the given address.
+// The transaction is sent using the send RPC when any option is set, as the
+// sendtoaddress RPC does not support setting the change address.
 func (c RPCClient) SendToAddress(
-	_ context.Context,
+	ctx context.Context,
 	address string,
-	amount float64,
+	amount privatebtc.Amount,
+	opts privatebtc.SendOptions,
 ) (string, error) {
-	am, err := btcutil.NewAmount(amount)
-	if err != nil {
-		return "", fmt.Errorf("new amount: %w", err)
+	if err := opts.Validate(); err != nil {
+		return "", err
+	}
+
+	if !opts.IsZero() {
+		return c.send(ctx, address, amount, opts)
 	}
 
 	addr, err := btcutil.DecodeAddress(address, nil)
@@ -103,7 +312,9 @@ func (c RPCClient) SendToAddress(
 		return "", fmt.Errorf("decode address: %w", err)
 	}
 
-	h, err := c.client.SendToAddress(addr, am)
+	h, err := call(ctx, c, func(client *rpcclient.Client) (*chainhash.Hash, error) {
+		return client.SendToAddressAsync(addr, btcutil.Amount(amount)).Receive()
+	})
 	if err != nil {
 		return "", fmt.Errorf("send to address: %w", err)
 	}
@@ -111,11 +322,80 @@ func (c RPCClient) SendToAddress(
 	return h.String(), nil
 }
 
+// send sends the given amount to the given address using the send RPC.
+// Requires Bitcoin Core v21 or newer.
+func (c RPCClient) send(
+	ctx context.Context,
+	address string,
+	amount privatebtc.Amount,
+	opts privatebtc.SendOptions,
+) (string, error) {
+	outputs := []map[string]float64{{address: amount.ToBTC()}}
+
+	params, err := rawParams(outputs, nil, "unset", nil, sendOptions(opts))
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := c.rawRequest(ctx, "send", params)
+	if err != nil {
+		return "", fmt.Errorf("send request: %w", err)
+	}
+
+	var res struct {
+		Complete bool   `json:"complete"`
+		TxID     string `json:"txid"`
+	}
+
+	if err := json.Unmarshal(resp, &res); err != nil {
+		return "", fmt.Errorf("unmarshal response: %w", err)
+	}
+
+	if !res.Complete {
+		return "", privatebtc.ErrPSBTNotComplete
+	}
+
+	return res.TxID, nil
+}
+
+// sendOptions returns the options argument of the send RPC.
+func sendOptions(opts privatebtc.SendOptions) map[string]any {
+	options := map[string]any{}
+
+	if opts.FeeRate != 0 {
+		options["fee_rate"] = float64(opts.FeeRate)
+	}
+
+	if opts.ConfTarget != 0 {
+		options["conf_target"] = opts.ConfTarget
+	}
+
+	// the receiver is the single output.
+	if outputs := opts.SubtractFeeFromOutputs(1); outputs != nil {
+		options["subtract_fee_from_outputs"] = outputs
+	}
+
+	if opts.Replaceable {
+		options["replaceable"] = true
+	}
+
+	if opts.ChangeAddress != "" {
+		options["change_address"] = opts.ChangeAddress
+	}
+
+	if opts.LockTime != 0 {
+		options["locktime"] = opts.LockTime
+	}
+
+	return options
+}
+
 // SendCustomTransaction sends a custom transaction with the given inputs and amounts.
 func (c RPCClient) SendCustomTransaction(
-	_ context.Context,
+	ctx context.Context,
 	inputs []privatebtc.TransactionVin,
-	amounts map[string]float64,
+	amounts map[string]privatebtc.Amount,
+	lockTime uint32,
 ) (string, error) {
 	jsonInputs := make([]btcjson.TransactionInput, len(inputs))
 
@@ -134,25 +414,42 @@ func (c RPCClient) SendCustomTransaction(
 			return "", fmt.Errorf("decode address %q: %w", addr, err)
 		}
 
-		am, err := btcutil.NewAmount(amnt)
-		if err != nil {
-			return "", fmt.Errorf("new amount %f: %w", amnt, err)
-		}
+		btcAmounts[btcAddr] = btcutil.Amount(amnt)
+	}
+
+	var rawLockTime *int64
 
-		btcAmounts[btcAddr] = am
+	if lockTime != 0 {
+		l := int64(lockTime)
+		rawLockTime = &l
 	}
 
-	rawTx, err := c.client.CreateRawTransaction(jsonInputs, btcAmounts, nil)
+	rawTx, err := call(ctx, c, func(client *rpcclient.Client) (*wire.MsgTx, error) {
+		return client.CreateRawTransactionAsync(jsonInputs, btcAmounts, rawLockTime).Receive()
+	})
 	if err != nil {
 		return "", fmt.Errorf("create raw transaction: %w", err)
 	}
 
-	signedTx, _, err := c.client.SignRawTransactionWithWallet(rawTx)
+	// btcjson does not support the input sequence, the inputs keep their order.
+	for i := range inputs {
+		if inputs[i].Sequence != 0 {
+			rawTx.TxIn[i].Sequence = inputs[i].Sequence
+		}
+	}
+
+	signedTx, err := call(ctx, c, func(client *rpcclient.Client) (*wire.MsgTx, error) {
+		signedTx, _, err := client.SignRawTransactionWithWalletAsync(rawTx).Receive()
+
+		return signedTx, err
+	})
 	if err != nil {
 		return "", fmt.Errorf("sign raw transaction: %w", err)
 	}
 
-	hash, err := c.client.SendRawTransaction(signedTx, true)
+	hash, err := call(ctx, c, func(client *rpcclient.Client) (*chainhash.Hash, error) {
+		return client.SendRawTransactionAsync(signedTx, true).Receive()
+	})
 	if err != nil {
 		return "", fmt.Errorf("send raw transaction: %w", err)
 	}
@@ -162,7 +459,7 @@ func (c RPCClient) SendCustomTransaction(
 
 // GenerateToAddress generates numBlocks blocks and sends the coinbase to the given address.
 func (c RPCClient) GenerateToAddress(
-	_ context.Context,
+	ctx context.Context,
 	numBlocks int64,
 	address string,
 ) ([]string, error) {
@@ -171,7 +468,9 @@ func (c RPCClient) GenerateToAddress(
 		return nil, fmt.Errorf("decode address: %w", err)
 	}
 
-	hashes, err := c.client.GenerateToAddress(numBlocks, addr, nil)
+	hashes, err := call(ctx, c, func(client *rpcclient.Client) ([]*chainhash.Hash, error) {
+		return client.GenerateToAddressAsync(numBlocks, addr, nil).Receive()
+	})
 	if err != nil {
 		return nil, fmt.Errorf("generate to address: %w", err)
 	}
@@ -185,28 +484,30 @@ func (c RPCClient) GenerateToAddress(
 }
 
 // AddPeer adds a peer to the node.
-func (c RPCClient) AddPeer(_ context.Context, peer privatebtc.Node) error {
+func (c RPCClient) AddPeer(ctx context.Context, peer privatebtc.Node) error {
 	addr := fmt.Sprintf(
 		"%s:%s",
 		peer.NodeHandler().InternalIP(),
 		privatebtc.P2PRegtestDefaultPort,
 	)
 
-	return c.client.AddNode(addr, rpcclient.ANOneTry)
+	return exec(ctx, c, func(client *rpcclient.Client) error {
+		return client.AddNodeAsync(addr, rpcclient.ANOneTry).Receive()
+	})
 }
 
 // RemovePeer removes a peer from the node.
 func (c RPCClient) RemovePeer(ctx context.Context, peer privatebtc.Node) error {
-	peerInfo, err := c.client.GetPeerInfo()
+	peers, err := c.GetPeerInfo(ctx)
 	if err != nil {
-		return fmt.Errorf("get peer info: %w", err)
+		return err
 	}
 
 	var addr string
 
-	for i := range peerInfo {
-		if strings.Contains(peerInfo[i].Addr, peer.NodeHandler().InternalIP()) {
-			addr = peerInfo[i].Addr
+	for i := range peers {
+		if peers[i].IP() == peer.NodeHandler().InternalIP() {
+			addr = peers[i].Addr
 		}
 	}
 
@@ -214,52 +515,156 @@ func (c RPCClient) RemovePeer(ctx context.Context, peer privatebtc.Node) error {
 		return privatebtc.ErrPeerNotFound
 	}
 
-	eg, _ := errgroup.WithContext(ctx)
+	if _, err := c.rawRequest(
+		ctx,
+		"disconnectnode",
+		[]json.RawMessage{json.RawMessage(strconv.Quote(addr))},
+	); err != nil {
+		return fmt.Errorf("disconnect node: %w", err)
+	}
+
+	return nil
+}
+
+// GetPeerInfo returns the peers connected to the node.
+func (c RPCClient) GetPeerInfo(ctx context.Context) ([]privatebtc.Peer, error) {
+	resp, err := c.rawRequest(ctx, "getpeerinfo", nil)
+	if err != nil {
+		return nil, fmt.Errorf("get peer info request: %w", err)
+	}
+
+	// btcjson.GetPeerInfoResult lacks the connection type and the synced heights.
+	// nolint: tagliatelle
+	var res []struct {
+		ID             int      `json:"id"`
+		Addr           string   `json:"addr"`
+		Inbound        bool     `json:"inbound"`
+		ConnectionType string   `json:"connection_type"`
+		StartingHeight int      `json:"startingheight"`
+		SyncedHeaders  int      `json:"synced_headers"`
+		SyncedBlocks   int      `json:"synced_blocks"`
+		PingTime       float64  `json:"pingtime"`
+		BytesSent      uint64   `json:"bytessent"`
+		BytesRecv      uint64   `json:"bytesrecv"`
+		Services       string   `json:"services"`
+		ServicesNames  []string `json:"servicesnames"`
+		Version        int      `json:"version"`
+		SubVer         string   `json:"subver"`
+	}
+
+	if err := json.Unmarshal(resp, &res); err != nil {
+		return nil, fmt.Errorf("unmarshal response: %w", err)
+	}
 
-	eg.Go(func() error {
-		if _, err := c.client.RawRequest(
-			"disconnectnode",
-			[]json.RawMessage{json.RawMessage(strconv.Quote(addr))},
-		); err != nil {
-			return fmt.Errorf("disconnect node: %w", err)
+	peers := make([]privatebtc.Peer, len(res))
+
+	for i, p := range res {
+		peers[i] = privatebtc.Peer{
+			ID:             p.ID,
+			Addr:           p.Addr,
+			Inbound:        p.Inbound,
+			ConnectionType: p.ConnectionType,
+			StartingHeight: p.StartingHeight,
+			SyncedHeaders:  p.SyncedHeaders,
+			SyncedBlocks:   p.SyncedBlocks,
+			PingTime:       time.Duration(p.PingTime * float64(time.Second)),
+			BytesSent:      p.BytesSent,
+			BytesReceived:  p.BytesRecv,
+			Services:       p.Services,
+			ServicesNames:  p.ServicesNames,
+			Version:        p.Version,
+			SubVersion:     p.SubVer,
 		}
+	}
 
-		return nil
-	})
+	return peers, nil
+}
+
+// BanPeer disconnects the given peer and bans its IP address for the given duration,
+// or for the node default of 24 hours if zero.
+func (c RPCClient) BanPeer(ctx context.Context, peer privatebtc.Node, duration time.Duration) error {
+	params, err := rawParams(peer.NodeHandler().InternalIP(), "add", int64(duration.Seconds()))
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.rawRequest(ctx, "setban", params); err != nil {
+		return fmt.Errorf("set ban request: %w", err)
+	}
+
+	return nil
+}
+
+// UnbanPeer lifts the ban of the IP address of the given peer.
+func (c RPCClient) UnbanPeer(ctx context.Context, peer privatebtc.Node) error {
+	params, err := rawParams(peer.NodeHandler().InternalIP(), "remove")
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.rawRequest(ctx, "setban", params); err != nil {
+		return fmt.Errorf("set ban request: %w", err)
+	}
+
+	return nil
+}
+
+// SetNetworkActive enables or disables all the P2P network activity of the node.
+func (c RPCClient) SetNetworkActive(ctx context.Context, active bool) error {
+	params, err := rawParams(active)
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.rawRequest(ctx, "setnetworkactive", params); err != nil {
+		return fmt.Errorf("set network active request: %w", err)
+	}
 
-	return eg.Wait()
+	return nil
 }
 
 // GetBalance returns the balance of the wallet.
-func (c RPCClient) GetBalance(context.Context) (privatebtc.Balance, error) {
-	balances, err := c.client.GetBalances()
+func (c RPCClient) GetBalance(ctx context.Context) (privatebtc.Balance, error) {
+	balances, err := c.getBalances(ctx)
 	if err != nil {
 		return privatebtc.Balance{}, err
 	}
 
-	return privatebtc.Balance{
-		Trusted:  balances.Mine.Trusted,
-		Pending:  balances.Mine.UntrustedPending,
-		Immature: balances.Mine.Immature,
-	}, nil
+	var balance privatebtc.Balance
+
+	for _, b := range []struct {
+		name  string
+		btc   float64
+		value *privatebtc.Amount
+	}{
+		{name: "trusted", btc: balances.Mine.Trusted, value: &balance.Trusted},
+		{name: "pending", btc: balances.Mine.UntrustedPending, value: &balance.Pending},
+		{name: "immature", btc: balances.Mine.Immature, value: &balance.Immature},
+	} {
+		if *b.value, err = privatebtc.AmountFromBTC(b.btc); err != nil {
+			return privatebtc.Balance{}, fmt.Errorf("%s balance: %w", b.name, err)
+		}
+	}
+
+	return balance, nil
 }
 
 // GetPendingBalance returns the pending balance of the wallet.
-func (c RPCClient) GetPendingBalance() (float64, error) {
-	balances, err := c.client.GetBalances()
+func (c RPCClient) GetPendingBalance(ctx context.Context) (privatebtc.Amount, error) {
+	balances, err := c.getBalances(ctx)
 	if err != nil {
 		return 0, fmt.Errorf("get balances: %w", err)
 	}
 
-	return balances.Mine.UntrustedPending, nil
+	return privatebtc.AmountFromBTC(balances.Mine.UntrustedPending)
 }
 
 // GetTransaction returns a transaction by its hash.
 func (c RPCClient) GetTransaction(
-	_ context.Context,
+	ctx context.Context,
 	txHash string,
 ) (*privatebtc.Transaction, error) {
-	resp, err := c.client.RawRequest("getrawtransaction",
+	resp, err := c.rawRequest(ctx, "getrawtransaction",
 		[]json.RawMessage{
 			json.RawMessage(strconv.Quote(txHash)),
 			json.RawMessage("true"),
@@ -268,59 +673,20 @@ func (c RPCClient) GetTransaction(
 		return nil, fmt.Errorf("get tx request: %w", err)
 	}
 
-	// nolint: tagliatelle
-	var tx struct {
-		TxID      string `json:"txid"`
-		Hash      string `json:"hash"`
-		BlockHash string `json:"blockhash"`
-		Vin       []struct {
-			TxID string `json:"txid"`
-			Vout uint32 `json:"vout"`
-		} `json:"vin"`
-		Vouts []struct {
-			Value        float64 `json:"value"`
-			N            uint32  `json:"n"`
-			ScriptPubKey struct {
-				Address string `json:"address"`
-			} `json:"scriptPubKey"`
-		} `json:"vout"`
-	}
+	var tx rawTransaction
 
 	if err := json.Unmarshal(resp, &tx); err != nil {
 		return nil, fmt.Errorf("unmarshal response: %w", err)
 	}
 
-	vouts := make([]privatebtc.TransactionVout, len(tx.Vouts))
-
-	for i, v := range tx.Vouts {
-		vouts[i] = privatebtc.TransactionVout{
-			Value:        v.Value,
-			ScriptPubKey: struct{ Address string }{Address: v.ScriptPubKey.Address},
-			N:            v.N,
-		}
-	}
-
-	vins := make([]privatebtc.TransactionVin, len(tx.Vin))
-
-	for i, v := range tx.Vin {
-		vins[i] = privatebtc.TransactionVin{
-			TxID: v.TxID,
-			Vout: v.Vout,
-		}
-	}
-
-	return &privatebtc.Transaction{
-		TxID:      tx.TxID,
-		Hash:      tx.Hash,
-		BlockHash: tx.BlockHash,
-		Vout:      vouts,
-		Vin:       vins,
-	}, nil
+	return tx.toTransaction()
 }
 
 // ListAddresses returns all addresses in the wallet.
-func (c RPCClient) ListAddresses(context.Context) ([]string, error) {
-	resp, err := c.client.ListReceivedByAddressIncludeEmpty(1, true)
+func (c RPCClient) ListAddresses(ctx context.Context) ([]string, error) {
+	resp, err := call(ctx, c, func(client *rpcclient.Client) ([]btcjson.ListReceivedByAddressResult, error) {
+		return client.ListReceivedByAddressIncludeEmptyAsync(1, true).Receive()
+	})
 	if err != nil {
 		return nil, fmt.Errorf("list addresses: %w", err)
 	}
@@ -335,8 +701,10 @@ func (c RPCClient) ListAddresses(context.Context) ([]string, error) {
 }
 
 // GetBestBlockHash returns the hash of the best (tip) block in the longest block chain.
-func (c RPCClient) GetBestBlockHash(context.Context) (string, error) {
-	h, err := c.client.GetBestBlockHash()
+func (c RPCClient) GetBestBlockHash(ctx context.Context) (string, error) {
+	h, err := call(ctx, c, func(client *rpcclient.Client) (*chainhash.Hash, error) {
+		return client.GetBestBlockHashAsync().Receive()
+	})
 	if err != nil {
 		return "", err
 	}
@@ -345,79 +713,1141 @@ func (c RPCClient) GetBestBlockHash(context.Context) (string, error) {
 }
 
 // GetCoinbaseValue returns the coinbase for the next block.
-func (c RPCClient) GetCoinbaseValue(context.Context) (int64, error) {
-	res, err := c.client.GetBlockTemplate(&btcjson.TemplateRequest{
-		Mode:         "template",
-		Capabilities: []string{"coinbasevalue"},
-		Rules:        []string{"segwit"},
+func (c RPCClient) GetCoinbaseValue(ctx context.Context) (privatebtc.Amount, error) {
+	res, err := call(ctx, c, func(client *rpcclient.Client) (*btcjson.GetBlockTemplateResult, error) {
+		return client.GetBlockTemplateAsync(&btcjson.TemplateRequest{
+			Mode:         "template",
+			Capabilities: []string{"coinbasevalue"},
+			Rules:        []string{"segwit"},
+		}).Receive()
 	})
 	if err != nil {
 		return 0, fmt.Errorf("get block template: %w", err)
 	}
 
-	var v int64
+	var v privatebtc.Amount
 
 	if res.CoinbaseValue != nil {
-		v = *res.CoinbaseValue
+		v = privatebtc.Amount(*res.CoinbaseValue)
 	}
 
 	return v, nil
 }
 
-// GetTransactionOutputs returns the outputs of a transaction.
-func (c RPCClient) GetTransactionOutputs(
-	ctx context.Context,
-	txHash string,
-) ([]privatebtc.MempoolTransactionOutput, error) {
-	tx, err := c.GetTransaction(ctx, txHash)
+// GetBlock returns the block with the given hash along with its transactions.
+func (c RPCClient) GetBlock(ctx context.Context, blockHash string) (*privatebtc.Block, error) {
+	const verbosityWithTxs = "2"
+
+	resp, err := c.rawRequest(ctx, "getblock",
+		[]json.RawMessage{
+			json.RawMessage(strconv.Quote(blockHash)),
+			json.RawMessage(verbosityWithTxs),
+		})
 	if err != nil {
-		return nil, fmt.Errorf("get transaction: %w", err)
+		return nil, fmt.Errorf("get block request: %w", err)
 	}
 
-	outputs := make([]privatebtc.MempoolTransactionOutput, len(tx.Vout))
+	// nolint: tagliatelle
+	var block struct {
+		Hash              string           `json:"hash"`
+		Height            int              `json:"height"`
+		Confirmations     int              `json:"confirmations"`
+		PreviousBlockHash string           `json:"previousblockhash"`
+		NextBlockHash     string           `json:"nextblockhash"`
+		MerkleRoot        string           `json:"merkleroot"`
+		Time              int64            `json:"time"`
+		Tx                []rawTransaction `json:"tx"`
+	}
 
-	for i, v := range tx.Vout {
-		outputs[i] = privatebtc.MempoolTransactionOutput{
-			Address: v.ScriptPubKey.Address,
-			Value:   v.Value,
+	if err := json.Unmarshal(resp, &block); err != nil {
+		return nil, fmt.Errorf("unmarshal response: %w", err)
+	}
+
+	txs := make([]privatebtc.Transaction, len(block.Tx))
+
+	for i := range block.Tx {
+		// the block transactions do not carry the block hash.
+		block.Tx[i].BlockHash = block.Hash
+
+		tx, err := block.Tx[i].toTransaction()
+		if err != nil {
+			return nil, fmt.Errorf("tx %q: %w", block.Tx[i].TxID, err)
 		}
+
+		txs[i] = *tx
 	}
 
-	return outputs, nil
+	return &privatebtc.Block{
+		BlockHeader: privatebtc.BlockHeader{
+			Hash:              block.Hash,
+			Height:            block.Height,
+			Confirmations:     block.Confirmations,
+			PreviousBlockHash: block.PreviousBlockHash,
+			NextBlockHash:     block.NextBlockHash,
+			MerkleRoot:        block.MerkleRoot,
+			Time:              time.Unix(block.Time, 0),
+		},
+		Transactions: txs,
+	}, nil
 }
 
-var _ privatebtc.RPCClientFactory = (*RPCClientFactory)(nil)
+// GetBlockHeader returns the header of the block with the given hash.
+func (c RPCClient) GetBlockHeader(
+	ctx context.Context,
+	blockHash string,
+) (*privatebtc.BlockHeader, error) {
+	h, err := chainhash.NewHashFromStr(blockHash)
+	if err != nil {
+		return nil, fmt.Errorf("decode block hash: %w", err)
+	}
 
-// RPCClientFactory is a factory for RPC clients.
-type RPCClientFactory struct {
-	NoPing bool
+	header, err := call(ctx, c, func(client *rpcclient.Client) (*btcjson.GetBlockHeaderVerboseResult, error) {
+		return client.GetBlockHeaderVerboseAsync(h).Receive()
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get block header: %w", err)
+	}
+
+	return &privatebtc.BlockHeader{
+		Hash:              header.Hash,
+		Height:            int(header.Height),
+		Confirmations:     int(header.Confirmations),
+		PreviousBlockHash: header.PreviousHash,
+		NextBlockHash:     header.NextHash,
+		MerkleRoot:        header.MerkleRoot,
+		Time:              time.Unix(header.Time, 0),
+	}, nil
 }
 
-// NewRPCClient creates a new RPC client.
-func (f RPCClientFactory) NewRPCClient(hostPort,
-	rpcUser,
-	rpcPass string,
-) (privatebtc.RPCClient, error) {
-	rpcClient, err := rpcclient.New(&rpcclient.ConnConfig{
-		Host:         "localhost:" + hostPort,
-		User:         rpcUser,
-		Pass:         rpcPass,
-		DisableTLS:   true,
-		HTTPPostMode: true,
-	}, nil)
+// GetBlockHash returns the hash of the block at the given height in the active chain.
+func (c RPCClient) GetBlockHash(ctx context.Context, height int) (string, error) {
+	h, err := call(ctx, c, func(client *rpcclient.Client) (*chainhash.Hash, error) {
+		return client.GetBlockHashAsync(int64(height)).Receive()
+	})
 	if err != nil {
-		return nil, fmt.Errorf("create rpc client: %w", err)
+		return "", fmt.Errorf("get block hash: %w", err)
 	}
 
+	return h.String(), nil
+}
+
+// GetChainTips returns the tips of all the chains known by the node.
+func (c RPCClient) GetChainTips(ctx context.Context) ([]privatebtc.ChainTip, error) {
+	res, err := call(ctx, c, func(client *rpcclient.Client) ([]*btcjson.GetChainTipsResult, error) {
+		return client.GetChainTipsAsync().Receive()
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get chain tips: %w", err)
+	}
+
+	tips := make([]privatebtc.ChainTip, len(res))
+
+	for i := range res {
+		tips[i] = privatebtc.ChainTip{
+			Hash:      res[i].Hash,
+			Height:    int(res[i].Height),
+			BranchLen: int(res[i].BranchLen),
+			Status:    privatebtc.ChainTipStatus(res[i].Status),
+		}
+	}
+
+	return tips, nil
+}
+
+// SendRawTransaction broadcasts the given hex encoded signed transaction.
+func (c RPCClient) SendRawTransaction(ctx context.Context, txHex string) (string, error) {
+	txBytes, err := hex.DecodeString(txHex)
+	if err != nil {
+		return "", fmt.Errorf("decode tx hex: %w", err)
+	}
+
+	var tx wire.MsgTx
+
+	if err := tx.Deserialize(bytes.NewReader(txBytes)); err != nil {
+		return "", fmt.Errorf("deserialize tx: %w", err)
+	}
+
+	hash, err := call(ctx, c, func(client *rpcclient.Client) (*chainhash.Hash, error) {
+		return client.SendRawTransactionAsync(&tx, true).Receive()
+	})
+	if err != nil {
+		return "", fmt.Errorf("send raw transaction: %w", err)
+	}
+
+	return hash.String(), nil
+}
+
+// TestMempoolAccept tests whether the given transactions would be accepted into the mempool,
+// the transactions fee rate is not capped.
+func (c RPCClient) TestMempoolAccept(
+	ctx context.Context,
+	txHexes []string,
+) ([]privatebtc.MempoolAcceptResult, error) {
+	// a max fee rate of 0 allows any fee.
+	params, err := rawParams(txHexes, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.rawRequest(ctx, "testmempoolaccept", params)
+	if err != nil {
+		return nil, fmt.Errorf("test mempool accept request: %w", err)
+	}
+
+	// btcjson.TestMempoolAcceptResult lacks the package error.
+	var res []struct {
+		TxID         string `json:"txid"`
+		WTxID        string `json:"wtxid"`
+		PackageError string `json:"package-error"`
+		Allowed      bool   `json:"allowed"`
+		VSize        int    `json:"vsize"`
+		Fees         struct {
+			Base float64 `json:"base"`
+		} `json:"fees"`
+		RejectReason string `json:"reject-reason"`
+	}
+
+	if err := json.Unmarshal(resp, &res); err != nil {
+		return nil, fmt.Errorf("unmarshal response: %w", err)
+	}
+
+	results := make([]privatebtc.MempoolAcceptResult, len(res))
+
+	for i, r := range res {
+		fee, err := privatebtc.AmountFromBTC(r.Fees.Base)
+		if err != nil {
+			return nil, fmt.Errorf("tx %q fee: %w", r.TxID, err)
+		}
+
+		results[i] = privatebtc.MempoolAcceptResult{
+			TxID:         r.TxID,
+			WTxID:        r.WTxID,
+			Allowed:      r.Allowed,
+			VSize:        r.VSize,
+			Fee:          fee,
+			RejectReason: privatebtc.RejectReason(r.RejectReason),
+			PackageError: r.PackageError,
+		}
+	}
+
+	return results, nil
+}
+
+// SubmitPackage broadcasts the given transactions as a package.
+func (c RPCClient) SubmitPackage(ctx context.Context, txHexes []string) (*privatebtc.PackageSubmitResult, error) {
+	params, err := rawParams(txHexes)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.rawRequest(ctx, "submitpackage", params)
+	if err != nil {
+		return nil, fmt.Errorf("submit package request: %w", err)
+	}
+
+	// nolint: tagliatelle
+	var res struct {
+		PackageMsg string `json:"package_msg"`
+		TxResults  map[string]struct {
+			TxID       string `json:"txid"`
+			OtherWTxID string `json:"other-wtxid"`
+			VSize      int    `json:"vsize"`
+			Fees       struct {
+				Base float64 `json:"base"`
+			} `json:"fees"`
+			Error string `json:"error"`
+		} `json:"tx-results"`
+		ReplacedTransactions []string `json:"replaced-transactions"`
+	}
+
+	if err := json.Unmarshal(resp, &res); err != nil {
+		return nil, fmt.Errorf("unmarshal response: %w", err)
+	}
+
+	txResults := make(map[string]privatebtc.PackageTransactionResult, len(res.TxResults))
+
+	for wtxid, r := range res.TxResults {
+		fee, err := privatebtc.AmountFromBTC(r.Fees.Base)
+		if err != nil {
+			return nil, fmt.Errorf("tx %q fee: %w", r.TxID, err)
+		}
+
+		txResults[wtxid] = privatebtc.PackageTransactionResult{
+			TxID:       r.TxID,
+			OtherWTxID: r.OtherWTxID,
+			VSize:      r.VSize,
+			Fee:        fee,
+			Error:      privatebtc.RejectReason(r.Error),
+		}
+	}
+
+	return &privatebtc.PackageSubmitResult{
+		Message:              res.PackageMsg,
+		Transactions:         txResults,
+		ReplacedTransactions: res.ReplacedTransactions,
+	}, nil
+}
+
+// WalletCreateFundedPSBT creates a PSBT paying the given amounts, funded by the wallet,
+// paying the fee as configured by the given options.
+func (c RPCClient) WalletCreateFundedPSBT(
+	ctx context.Context,
+	inputs []privatebtc.TransactionVin,
+	amounts map[string]privatebtc.Amount,
+	opts privatebtc.SendOptions,
+) (*privatebtc.FundedPSBT, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	psbtInputs := make([]btcjson.PsbtInput, len(inputs))
+
+	// the sequence is always sent by btcjson.
+	for i := range inputs {
+		sequence := inputs[i].Sequence
+		if sequence == 0 {
+			sequence = opts.InputSequence()
+		}
+
+		psbtInputs[i] = btcjson.PsbtInput{
+			Txid:     inputs[i].TxID,
+			Vout:     inputs[i].Vout,
+			Sequence: sequence,
+		}
+	}
+
+	var lockTime *uint32
+
+	if opts.LockTime != 0 {
+		lockTime = &opts.LockTime
+	}
+
+	psbtOutputs := make([]btcjson.PsbtOutput, 0, len(amounts))
+
+	for addr, amnt := range amounts {
+		psbtOutputs = append(psbtOutputs, btcjson.NewPsbtOutput(addr, btcutil.Amount(amnt)))
+	}
+
+	res, err := call(ctx, c, func(client *rpcclient.Client) (*btcjson.WalletCreateFundedPsbtResult, error) {
+		return client.WalletCreateFundedPsbtAsync(
+			psbtInputs,
+			psbtOutputs,
+			lockTime,
+			walletCreateFundedPSBTOptions(opts, len(psbtOutputs)),
+			nil,
+		).Receive()
+	})
+	if err != nil {
+		return nil, fmt.Errorf("wallet create funded psbt: %w", err)
+	}
+
+	fee, err := privatebtc.AmountFromBTC(res.Fee)
+	if err != nil {
+		return nil, fmt.Errorf("fee: %w", err)
+	}
+
+	return &privatebtc.FundedPSBT{
+		PSBT:      res.Psbt,
+		Fee:       fee,
+		ChangePos: int(res.ChangePos),
+	}, nil
+}
+
+// walletCreateFundedPSBTOptions returns the options argument of the
+// walletcreatefundedpsbt RPC.
+func walletCreateFundedPSBTOptions(
+	opts privatebtc.SendOptions,
+	outputs int,
+) *btcjson.WalletCreateFundedPsbtOpts {
+	if opts.IsZero() {
+		return nil
+	}
+
+	var options btcjson.WalletCreateFundedPsbtOpts
+
+	if opts.FeeRate != 0 {
+		// btcjson only supports the feeRate option, which is expressed in BTC/kvB.
+		feeRate := float64(opts.FeeRate) * 1000 / btcutil.SatoshiPerBitcoin
+		options.FeeRate = &feeRate
+	}
+
+	if opts.ConfTarget != 0 {
+		confTarget := int64(opts.ConfTarget)
+		options.ConfTarget = &confTarget
+	}
+
+	if outputs := opts.SubtractFeeFromOutputs(outputs); outputs != nil {
+		subtractFeeFromOutputs := make([]int64, len(outputs))
+		for i := range outputs {
+			subtractFeeFromOutputs[i] = int64(outputs[i])
+		}
+
+		options.SubtractFeeFromOutputs = &subtractFeeFromOutputs
+	}
+
+	if opts.Replaceable {
+		options.Replaceable = &opts.Replaceable
+	}
+
+	if opts.ChangeAddress != "" {
+		options.ChangeAddress = &opts.ChangeAddress
+	}
+
+	return &options
+}
+
+// WalletProcessPSBT updates the given PSBT with the wallet data and signs its
+// inputs if sign is true.
+func (c RPCClient) WalletProcessPSBT(
+	ctx context.Context,
+	psbt string,
+	sign bool,
+) (*privatebtc.ProcessedPSBT, error) {
+	res, err := call(ctx, c, func(client *rpcclient.Client) (*btcjson.WalletProcessPsbtResult, error) {
+		return client.WalletProcessPsbtAsync(psbt, &sign, rpcclient.SigHashAll, nil).Receive()
+	})
+	if err != nil {
+		return nil, fmt.Errorf("wallet process psbt: %w", err)
+	}
+
+	return &privatebtc.ProcessedPSBT{
+		PSBT:     res.Psbt,
+		Complete: res.Complete,
+	}, nil
+}
+
+// CombinePSBT combines the given PSBTs of the same transaction into a single PSBT.
+func (c RPCClient) CombinePSBT(ctx context.Context, psbts []string) (string, error) {
+	params, err := rawParams(psbts)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := c.rawRequest(ctx, "combinepsbt", params)
+	if err != nil {
+		return "", fmt.Errorf("combine psbt request: %w", err)
+	}
+
+	var combined string
+
+	if err := json.Unmarshal(resp, &combined); err != nil {
+		return "", fmt.Errorf("unmarshal response: %w", err)
+	}
+
+	return combined, nil
+}
+
+// FinalizePSBT finalizes the given PSBT, extracting the network transaction if
+// the PSBT is complete.
+func (c RPCClient) FinalizePSBT(ctx context.Context, psbt string) (*privatebtc.FinalizedPSBT, error) {
+	resp, err := c.rawRequest(ctx, "finalizepsbt", []json.RawMessage{
+		json.RawMessage(strconv.Quote(psbt)),
+		json.RawMessage("true"),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("finalize psbt request: %w", err)
+	}
+
+	var res struct {
+		PSBT     string `json:"psbt"`
+		Hex      string `json:"hex"`
+		Complete bool   `json:"complete"`
+	}
+
+	if err := json.Unmarshal(resp, &res); err != nil {
+		return nil, fmt.Errorf("unmarshal response: %w", err)
+	}
+
+	return &privatebtc.FinalizedPSBT{
+		PSBT:     res.PSBT,
+		Hex:      res.Hex,
+		Complete: res.Complete,
+	}, nil
+}
+
+// AnalyzePSBT analyzes the given PSBT and returns the next role of the workflow.
+func (c RPCClient) AnalyzePSBT(ctx context.Context, psbt string) (*privatebtc.PSBTAnalysis, error) {
+	resp, err := c.rawRequest(ctx, "analyzepsbt", []json.RawMessage{
+		json.RawMessage(strconv.Quote(psbt)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("analyze psbt request: %w", err)
+	}
+
+	// nolint: tagliatelle
+	var res struct {
+		Inputs []struct {
+			HasUTXO bool `json:"has_utxo"`
+			IsFinal bool `json:"is_final"`
+			Missing struct {
+				PubKeys       []string `json:"pubkeys"`
+				Signatures    []string `json:"signatures"`
+				RedeemScript  string   `json:"redeemscript"`
+				WitnessScript string   `json:"witnessscript"`
+			} `json:"missing"`
+			Next string `json:"next"`
+		} `json:"inputs"`
+		EstimatedVSize   int     `json:"estimated_vsize"`
+		EstimatedFeeRate float64 `json:"estimated_feerate"`
+		Fee              float64 `json:"fee"`
+		Next             string  `json:"next"`
+		Error            string  `json:"error"`
+	}
+
+	if err := json.Unmarshal(resp, &res); err != nil {
+		return nil, fmt.Errorf("unmarshal response: %w", err)
+	}
+
+	// the estimated fee rate is expressed in BTC/kvB.
+	feePerKvB, err := privatebtc.AmountFromBTC(res.EstimatedFeeRate)
+	if err != nil {
+		return nil, fmt.Errorf("estimated fee rate: %w", err)
+	}
+
+	fee, err := privatebtc.AmountFromBTC(res.Fee)
+	if err != nil {
+		return nil, fmt.Errorf("fee: %w", err)
+	}
+
+	inputs := make([]privatebtc.PSBTInputAnalysis, len(res.Inputs))
+
+	for i, in := range res.Inputs {
+		inputs[i] = privatebtc.PSBTInputAnalysis{
+			HasUTXO:              in.HasUTXO,
+			IsFinal:              in.IsFinal,
+			MissingPubKeys:       in.Missing.PubKeys,
+			MissingSignatures:    in.Missing.Signatures,
+			MissingRedeemScript:  in.Missing.RedeemScript,
+			MissingWitnessScript: in.Missing.WitnessScript,
+			Next:                 privatebtc.PSBTRole(in.Next),
+		}
+	}
+
+	return &privatebtc.PSBTAnalysis{
+		Inputs:           inputs,
+		EstimatedVSize:   res.EstimatedVSize,
+		EstimatedFeeRate: privatebtc.NewFeeRate(feePerKvB, 1000),
+		Fee:              fee,
+		Next:             privatebtc.PSBTRole(res.Next),
+		Error:            res.Error,
+	}, nil
+}
+
+// BumpFee replaces the given wallet transaction with a transaction paying the
+// given fee rate, keeping its outputs and reducing its change.
+func (c RPCClient) BumpFee(
+	ctx context.Context,
+	txHash string,
+	feeRate privatebtc.FeeRate,
+) (*privatebtc.BumpedTransaction, error) {
+	return c.bumpFee(ctx, "bumpfee", txHash, feeRate)
+}
+
+// PSBTBumpFee creates an unsigned PSBT replacing the given wallet transaction with
+// a transaction paying the given fee rate.
+func (c RPCClient) PSBTBumpFee(
+	ctx context.Context,
+	txHash string,
+	feeRate privatebtc.FeeRate,
+) (*privatebtc.BumpedTransaction, error) {
+	return c.bumpFee(ctx, "psbtbumpfee", txHash, feeRate)
+}
+
+// bumpFee calls the bumpfee or the psbtbumpfee RPC, which share their arguments
+// and differ only in returning the replacement txid or PSBT.
+func (c RPCClient) bumpFee(
+	ctx context.Context,
+	method string,
+	txHash string,
+	feeRate privatebtc.FeeRate,
+) (*privatebtc.BumpedTransaction, error) {
+	// nolint: tagliatelle
+	type options struct {
+		FeeRate float64 `json:"fee_rate"`
+	}
+
+	params, err := rawParams(txHash, options{FeeRate: float64(feeRate)})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.rawRequest(ctx, method, params)
+	if err != nil {
+		return nil, fmt.Errorf("%s request: %w", method, err)
+	}
+
+	// nolint: tagliatelle
+	var res struct {
+		TxID    string  `json:"txid"`
+		PSBT    string  `json:"psbt"`
+		OrigFee float64 `json:"origfee"`
+		Fee     float64 `json:"fee"`
+	}
+
+	if err := json.Unmarshal(resp, &res); err != nil {
+		return nil, fmt.Errorf("unmarshal response: %w", err)
+	}
+
+	bumped := privatebtc.BumpedTransaction{
+		TxID: res.TxID,
+		PSBT: res.PSBT,
+	}
+
+	if bumped.OriginalFee, err = privatebtc.AmountFromBTC(res.OrigFee); err != nil {
+		return nil, fmt.Errorf("original fee: %w", err)
+	}
+
+	if bumped.Fee, err = privatebtc.AmountFromBTC(res.Fee); err != nil {
+		return nil, fmt.Errorf("fee: %w", err)
+	}
+
+	return &bumped, nil
+}
+
+// CreateWatchOnlyWallet creates a blank descriptor wallet, with private keys
+// disabled, with the given name.
+func (c RPCClient) CreateWatchOnlyWallet(ctx context.Context, walletName string) error {
+	const (
+		disablePrivateKeys = true
+		blank              = true
+		passphrase         = ""
+		avoidReuse         = false
+		descriptors        = true
+	)
+
+	params, err := rawParams(walletName, disablePrivateKeys, blank, passphrase, avoidReuse, descriptors)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.rawRequest(ctx, "createwallet", params)
+	if err != nil {
+		return fmt.Errorf("create wallet: %w", err)
+	}
+
+	var res btcjson.CreateWalletResult
+
+	if err := json.Unmarshal(resp, &res); err != nil {
+		return fmt.Errorf("unmarshal response: %w", err)
+	}
+
+	if res.Warning != "" {
+		return WalletWarningError(res.Warning)
+	}
+
+	return nil
+}
+
+// Wallet returns a client whose wallet RPCs are served by the wallet with the given name.
+func (c RPCClient) Wallet(walletName string) (privatebtc.RPCClient, error) {
+	c.config.Host = c.host + "/wallet/" + url.PathEscape(walletName)
+
+	return c, nil
+}
+
+// ListDescriptors returns the descriptors of the wallet, including their private
+// keys if private is true.
+func (c RPCClient) ListDescriptors(ctx context.Context, private bool) ([]privatebtc.Descriptor, error) {
+	params, err := rawParams(private)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.rawRequest(ctx, "listdescriptors", params)
+	if err != nil {
+		return nil, fmt.Errorf("list descriptors request: %w", err)
+	}
+
+	var res struct {
+		Descriptors []struct {
+			Desc      string  `json:"desc"`
+			Timestamp int64   `json:"timestamp"`
+			Active    bool    `json:"active"`
+			Internal  bool    `json:"internal"`
+			Range     *[2]int `json:"range"`
+			Next      int     `json:"next"`
+		} `json:"descriptors"`
+	}
+
+	if err := json.Unmarshal(resp, &res); err != nil {
+		return nil, fmt.Errorf("unmarshal response: %w", err)
+	}
+
+	descriptors := make([]privatebtc.Descriptor, len(res.Descriptors))
+
+	for i, d := range res.Descriptors {
+		descriptors[i] = privatebtc.Descriptor{
+			Desc:      d.Desc,
+			Timestamp: time.Unix(d.Timestamp, 0),
+			Active:    d.Active,
+			Internal:  d.Internal,
+			Range:     d.Range,
+			Next:      d.Next,
+		}
+	}
+
+	return descriptors, nil
+}
+
+// ImportDescriptors imports the given descriptors into the wallet.
+func (c RPCClient) ImportDescriptors(
+	ctx context.Context,
+	requests []privatebtc.ImportDescriptorRequest,
+) error {
+	type importRequest struct {
+		Desc      string  `json:"desc"`
+		Active    bool    `json:"active"`
+		Internal  bool    `json:"internal"`
+		Range     *[2]int `json:"range,omitempty"`
+		Label     string  `json:"label,omitempty"`
+		Timestamp any     `json:"timestamp"`
+	}
+
+	reqs := make([]importRequest, len(requests))
+
+	for i, r := range requests {
+		var timestamp any = "now"
+		if !r.Timestamp.IsZero() {
+			timestamp = r.Timestamp.Unix()
+		}
+
+		reqs[i] = importRequest{
+			Desc:      r.Desc,
+			Active:    r.Active,
+			Internal:  r.Internal,
+			Range:     r.Range,
+			Label:     r.Label,
+			Timestamp: timestamp,
+		}
+	}
+
+	params, err := rawParams(reqs)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.rawRequest(ctx, "importdescriptors", params)
+	if err != nil {
+		return fmt.Errorf("import descriptors request: %w", err)
+	}
+
+	var results []struct {
+		Success bool `json:"success"`
+		Error   *struct {
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+
+	if err := json.Unmarshal(resp, &results); err != nil {
+		return fmt.Errorf("unmarshal response: %w", err)
+	}
+
+	var errs error
+
+	for i, res := range results {
+		if res.Success {
+			continue
+		}
+
+		var msg string
+		if res.Error != nil {
+			msg = res.Error.Message
+		}
+
+		errs = errors.Join(errs, fmt.Errorf(
+			"descriptor %d: %s: %w",
+			i,
+			msg,
+			privatebtc.ErrImportDescriptorFailed,
+		))
+	}
+
+	return errs
+}
+
+// GetDescriptorInfo analyzes the given descriptor.
+func (c RPCClient) GetDescriptorInfo(
+	ctx context.Context,
+	descriptor string,
+) (*privatebtc.DescriptorInfo, error) {
+	res, err := call(ctx, c, func(client *rpcclient.Client) (*btcjson.GetDescriptorInfoResult, error) {
+		return client.GetDescriptorInfoAsync(descriptor).Receive()
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get descriptor info: %w", err)
+	}
+
+	return &privatebtc.DescriptorInfo{
+		Descriptor:     res.Descriptor,
+		Checksum:       res.Checksum,
+		IsRange:        res.IsRange,
+		IsSolvable:     res.IsSolvable,
+		HasPrivateKeys: res.HasPrivateKeys,
+	}, nil
+}
+
+// DeriveAddress returns the address of the given descriptor, which must not be ranged.
+func (c RPCClient) DeriveAddress(ctx context.Context, descriptor string) (string, error) {
+	params, err := rawParams(descriptor)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := c.rawRequest(ctx, "deriveaddresses", params)
+	if err != nil {
+		return "", fmt.Errorf("derive addresses request: %w", err)
+	}
+
+	var addresses []string
+
+	if err := json.Unmarshal(resp, &addresses); err != nil {
+		return "", fmt.Errorf("unmarshal response: %w", err)
+	}
+
+	if len(addresses) == 0 {
+		return "", privatebtc.ErrDescriptorWithoutAddress
+	}
+
+	return addresses[0], nil
+}
+
+// GetAddressInfo returns the information the wallet has about the given address.
+func (c RPCClient) GetAddressInfo(ctx context.Context, address string) (*privatebtc.AddressInfo, error) {
+	params, err := rawParams(address)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.rawRequest(ctx, "getaddressinfo", params)
+	if err != nil {
+		return nil, fmt.Errorf("get address info request: %w", err)
+	}
+
+	// nolint: tagliatelle
+	var res struct {
+		Address   string `json:"address"`
+		IsMine    bool   `json:"ismine"`
+		Desc      string `json:"desc"`
+		HDKeyPath string `json:"hdkeypath"`
+	}
+
+	if err := json.Unmarshal(resp, &res); err != nil {
+		return nil, fmt.Errorf("unmarshal response: %w", err)
+	}
+
+	return &privatebtc.AddressInfo{
+		Address:    res.Address,
+		IsMine:     res.IsMine,
+		Descriptor: res.Desc,
+		HDKeyPath:  res.HDKeyPath,
+	}, nil
+}
+
+// CreateMultisig creates a P2WSH multisig address requiring nRequired signatures
+// of the given hex encoded public keys.
+func (c RPCClient) CreateMultisig(
+	ctx context.Context,
+	nRequired int,
+	pubKeys []string,
+) (*privatebtc.Multisig, error) {
+	params, err := rawParams(nRequired, pubKeys, "bech32")
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.rawRequest(ctx, "createmultisig", params)
+	if err != nil {
+		return nil, fmt.Errorf("create multisig request: %w", err)
+	}
+
+	var res struct {
+		Address      string `json:"address"`
+		RedeemScript string `json:"redeemScript"`
+		Descriptor   string `json:"descriptor"`
+	}
+
+	if err := json.Unmarshal(resp, &res); err != nil {
+		return nil, fmt.Errorf("unmarshal response: %w", err)
+	}
+
+	return &privatebtc.Multisig{
+		Address:      res.Address,
+		RedeemScript: res.RedeemScript,
+		Descriptor:   res.Descriptor,
+	}, nil
+}
+
+// GetTransactionOutputs returns the outputs of a mempool transaction.
+func (c RPCClient) GetTransactionOutputs(
+	ctx context.Context,
+	txHash string,
+) ([]privatebtc.MempoolTransactionOutput, error) {
+	tx, err := c.GetTransaction(ctx, txHash)
+	if err != nil {
+		if isTxNotInMempoolError(err) {
+			return nil, fmt.Errorf("tx %q: %w", txHash, privatebtc.ErrTxNotFoundInMempool)
+		}
+
+		return nil, fmt.Errorf("get transaction: %w", err)
+	}
+
+	outputs := make([]privatebtc.MempoolTransactionOutput, len(tx.Vout))
+
+	for i, v := range tx.Vout {
+		outputs[i] = privatebtc.MempoolTransactionOutput{
+			Address: v.ScriptPubKey.Address,
+			Value:   v.Value,
+		}
+	}
+
+	return outputs, nil
+}
+
+// rawTransaction is the verbose transaction of the getrawtransaction and getblock RPCs.
+// nolint: tagliatelle
+type rawTransaction struct {
+	TxID      string `json:"txid"`
+	Hash      string `json:"hash"`
+	BlockHash string `json:"blockhash"`
+	Vin       []struct {
+		TxID string `json:"txid"`
+		Vout uint32 `json:"vout"`
+	} `json:"vin"`
+	Vouts []struct {
+		Value        float64 `json:"value"`
+		N            uint32  `json:"n"`
+		ScriptPubKey struct {
+			Address string `json:"address"`
+		} `json:"scriptPubKey"`
+	} `json:"vout"`
+}
+
+func (tx rawTransaction) toTransaction() (*privatebtc.Transaction, error) {
+	vouts := make([]privatebtc.TransactionVout, len(tx.Vouts))
+
+	for i, v := range tx.Vouts {
+		value, err := privatebtc.AmountFromBTC(v.Value)
+		if err != nil {
+			return nil, fmt.Errorf("vout %d value: %w", v.N, err)
+		}
+
+		vouts[i] = privatebtc.TransactionVout{
+			Value:        value,
+			ScriptPubKey: struct{ Address string }{Address: v.ScriptPubKey.Address},
+			N:            v.N,
+		}
+	}
+
+	vins := make([]privatebtc.TransactionVin, len(tx.Vin))
+
+	for i, v := range tx.Vin {
+		vins[i] = privatebtc.TransactionVin{
+			TxID: v.TxID,
+			Vout: v.Vout,
+		}
+	}
+
+	return &privatebtc.Transaction{
+		TxID:      tx.TxID,
+		Hash:      tx.Hash,
+		BlockHash: tx.BlockHash,
+		Vout:      vouts,
+		Vin:       vins,
+	}, nil
+}
+
+// maxConfirmations is the listunspent maxconf default value, used when the
+// filter has no maximum.
+const maxConfirmations = 9999999
+
+// ListUnspent returns the wallet UTXOs matching the given filter.
+func (c RPCClient) ListUnspent(
+	ctx context.Context,
+	filter privatebtc.UTXOFilter,
+) ([]privatebtc.UTXO, error) {
+	maxConf := filter.MaxConf
+	if maxConf == 0 {
+		maxConf = maxConfirmations
+	}
+
+	addresses := filter.Addresses
+	if addresses == nil {
+		addresses = []string{}
+	}
+
+	params, err := rawParams(filter.MinConf, maxConf, addresses)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.rawRequest(ctx, "listunspent", params)
+	if err != nil {
+		return nil, fmt.Errorf("list unspent request: %w", err)
+	}
+
+	var res []struct {
+		TxID          string  `json:"txid"`
+		Vout          uint32  `json:"vout"`
+		Address       string  `json:"address"`
+		Label         string  `json:"label"`
+		Amount        float64 `json:"amount"`
+		Confirmations int     `json:"confirmations"`
+		Spendable     bool    `json:"spendable"`
+		Safe          bool    `json:"safe"`
+	}
+
+	if err := json.Unmarshal(resp, &res); err != nil {
+		return nil, fmt.Errorf("unmarshal response: %w", err)
+	}
+
+	utxos := make([]privatebtc.UTXO, len(res))
+
+	for i, u := range res {
+		amount, err := privatebtc.AmountFromBTC(u.Amount)
+		if err != nil {
+			return nil, fmt.Errorf("utxo %s:%d amount: %w", u.TxID, u.Vout, err)
+		}
+
+		utxos[i] = privatebtc.UTXO{
+			TxID:          u.TxID,
+			Vout:          u.Vout,
+			Address:       u.Address,
+			Label:         u.Label,
+			Amount:        amount,
+			Confirmations: u.Confirmations,
+			Spendable:     u.Spendable,
+			Safe:          u.Safe,
+		}
+	}
+
+	return utxos, nil
+}
+
+// LockUnspent locks the given UTXOs, so that the wallet does not select them
+// when funding transactions.
+func (c RPCClient) LockUnspent(ctx context.Context, outpoints []privatebtc.TransactionVin) error {
+	return c.lockUnspent(ctx, false, outpoints)
+}
+
+// UnlockUnspent unlocks the given UTXOs, or every locked UTXO if none are given.
+func (c RPCClient) UnlockUnspent(ctx context.Context, outpoints []privatebtc.TransactionVin) error {
+	return c.lockUnspent(ctx, true, outpoints)
+}
+
+func (c RPCClient) lockUnspent(
+	ctx context.Context,
+	unlock bool,
+	outpoints []privatebtc.TransactionVin,
+) error {
+	args := []any{unlock}
+
+	// without outpoints, the whole wallet is unlocked.
+	if len(outpoints) != 0 {
+		inputs := make([]btcjson.TransactionInput, len(outpoints))
+		for i := range outpoints {
+			inputs[i] = btcjson.TransactionInput{Txid: outpoints[i].TxID, Vout: outpoints[i].Vout}
+		}
+
+		args = append(args, inputs)
+	}
+
+	params, err := rawParams(args...)
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.rawRequest(ctx, "lockunspent", params); err != nil {
+		return fmt.Errorf("lock unspent request: %w", err)
+	}
+
+	return nil
+}
+
+// ListLockUnspent returns the locked UTXOs.
+func (c RPCClient) ListLockUnspent(ctx context.Context) ([]privatebtc.TransactionVin, error) {
+	resp, err := c.rawRequest(ctx, "listlockunspent", nil)
+	if err != nil {
+		return nil, fmt.Errorf("list lock unspent request: %w", err)
+	}
+
+	var res []btcjson.TransactionInput
+
+	if err := json.Unmarshal(resp, &res); err != nil {
+		return nil, fmt.Errorf("unmarshal response: %w", err)
+	}
+
+	outpoints := make([]privatebtc.TransactionVin, len(res))
+	for i := range res {
+		outpoints[i] = privatebtc.TransactionVin{TxID: res[i].Txid, Vout: res[i].Vout}
+	}
+
+	return outpoints, nil
+}
+
+func (c RPCClient) getBalances(ctx context.Context) (*btcjson.GetBalancesResult, error) {
+	return call(ctx, c, func(client *rpcclient.Client) (*btcjson.GetBalancesResult, error) {
+		return client.GetBalancesAsync().Receive()
+	})
+}
+
+// rawParams marshals the given params of a raw request.
+func rawParams(params ...any) ([]json.RawMessage, error) {
+	rawParams := make([]json.RawMessage, len(params))
+
+	for i := range params {
+		p, err := json.Marshal(params[i])
+		if err != nil {
+			return nil, fmt.Errorf("marshal param %d: %w", i, err)
+		}
+
+		rawParams[i] = p
+	}
+
+	return rawParams, nil
+}
+
+func (c RPCClient) rawRequest(
+	ctx context.Context,
+	method string,
+	params []json.RawMessage,
+) (json.RawMessage, error) {
+	return call(ctx, c, func(client *rpcclient.Client) (json.RawMessage, error) {
+		return client.RawRequestAsync(method, params).Receive()
+	})
+}
+
+var _ privatebtc.RPCClientFactory = (*RPCClientFactory)(nil)
+
+// RPCClientFactory is a factory for RPC clients.
+type RPCClientFactory struct {
+	NoPing bool
+}
+
+// NewRPCClient creates a new RPC client, pinging the node unless NoPing is set.
+func (f RPCClientFactory) NewRPCClient(
+	ctx context.Context,
+	hostPort,
+	rpcUser,
+	rpcPass string,
+) (privatebtc.RPCClient, error) {
 	c := RPCClient{
-		client: rpcClient,
+		config: rpcclient.ConnConfig{
+			Host:         "localhost:" + hostPort,
+			User:         rpcUser,
+			Pass:         rpcPass,
+			DisableTLS:   true,
+			HTTPPostMode: true,
+		},
+		host: "localhost:" + hostPort,
 	}
 
 	if f.NoPing {
 		return c, nil
 	}
 
-	if err := rpcClient.Ping(); err != nil {
+	if err := exec(ctx, c, func(client *rpcclient.Client) error {
+		return client.PingAsync().Receive()
+	}); err != nil {
 		return nil, fmt.Errorf("ping: %w", err)
 	}
 
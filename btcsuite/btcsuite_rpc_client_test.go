@@ -0,0 +1,110 @@
+package btcsuite_test
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/adrianbrad/privatebtc"
+	"github.com/adrianbrad/privatebtc/btcsuite"
+	"github.com/stretchr/testify/require"
+)
+
+// newStalledRPCClient returns an RPC client connected to a node which never responds
+// to the first request, the later requests are answered with a block count of 101.
+func newStalledRPCClient(t *testing.T) (privatebtc.RPCClient, *atomic.Int64) {
+	t.Helper()
+
+	var (
+		requests atomic.Int64
+		stalled  = make(chan struct{})
+	)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if requests.Add(1) == 1 {
+			<-stalled
+
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"result":101,"error":null,"id":1}`))
+	}))
+
+	t.Cleanup(func() {
+		close(stalled)
+		srv.Close()
+	})
+
+	_, port, err := net.SplitHostPort(srv.Listener.Addr().String())
+	require.NoError(t, err)
+
+	c, err := btcsuite.RPCClientFactory{NoPing: true}.NewRPCClient(context.Background(), port, "user", "pass")
+	require.NoError(t, err)
+
+	return c, &requests
+}
+
+func TestRPCClientContext(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Deadline", func(t *testing.T) {
+		t.Parallel()
+
+		c, requests := newStalledRPCClient(t)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+
+		start := time.Now()
+
+		_, err := c.GetBlockCount(ctx)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+
+		require.Less(t, time.Since(start), time.Second)
+		require.Eventually(t, func() bool { return requests.Load() == 1 }, time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("CanceledCallDoesNotDelayNextCall", func(t *testing.T) {
+		t.Parallel()
+
+		c, requests := newStalledRPCClient(t)
+
+		ctx, cancel := context.WithCancel(context.Background())
+
+		go func() {
+			time.Sleep(100 * time.Millisecond)
+			cancel()
+		}()
+
+		_, err := c.GetBlockCount(ctx)
+		require.ErrorIs(t, err, context.Canceled)
+
+		// the first request is still stalled.
+		ctx, cancel = context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		count, err := c.GetBlockCount(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 101, count)
+
+		require.Equal(t, int64(2), requests.Load())
+	})
+
+	t.Run("AlreadyCanceled", func(t *testing.T) {
+		t.Parallel()
+
+		c, requests := newStalledRPCClient(t)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := c.CreateWallet(ctx, "wallet")
+		require.ErrorIs(t, err, context.Canceled)
+
+		require.Zero(t, requests.Load())
+	})
+}
@@ -1,3 +1,4 @@
 // Package btcsuite provides implementations of the privatebtc.RPCClientFactory
 // and privatebtc.RPCClient interfaces using the https://github.com/ory/dockertest package.
+// Every RPC call honours the cancellation and the deadline of its context.
 package btcsuite
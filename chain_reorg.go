@@ -32,9 +32,15 @@ type ChainReorgManager interface {
 	SendTransactionOnNetwork(
 		ctx context.Context,
 		receiverAddress string,
-		amount float64,
+		amount Amount,
+		opts SendOptions,
+	) (string, error)
+	SendTransactionOnDisconnectedNode(
+		ctx context.Context,
+		receiverAddress string,
+		amount Amount,
+		opts SendOptions,
 	) (string, error)
-	SendTransactionOnDisconnectedNode(ctx context.Context, receiverAddress string, amount float64) (string, error)
 	MineBlocksOnNetwork(ctx context.Context, numBlocks int64) ([]string, error)
 	MineBlocksOnDisconnectedNode(ctx context.Context, numBlocks int64) ([]string, error)
 	ReconnectNode(ctx context.Context) error
@@ -47,6 +53,8 @@ type ChainReorg struct {
 	disconnectedNode Node
 	networkNodes     Nodes
 	logger           *slog.Logger
+	timeouts         timeouts
+	clock            *Clock
 
 	disconnected bool
 }
@@ -55,14 +63,18 @@ type ChainReorg struct {
 func (n *PrivateNetwork) NewChainReorg(
 	disconnectedNodeIndex int,
 ) (*ChainReorg, error) {
-	if disconnectedNodeIndex < 0 || disconnectedNodeIndex >= len(n.nodes) {
+	nodes := n.Nodes()
+
+	if disconnectedNodeIndex < 0 || disconnectedNodeIndex >= len(nodes) {
 		return nil, fmt.Errorf("index %d: %w", disconnectedNodeIndex, ErrNodeIndexOutOfRange)
 	}
 
 	return &ChainReorg{
-		disconnectedNode: n.nodes[disconnectedNodeIndex],
-		networkNodes:     slices.Delete(n.Nodes(), disconnectedNodeIndex, disconnectedNodeIndex+1),
+		disconnectedNode: nodes[disconnectedNodeIndex],
+		networkNodes:     slices.Delete(slices.Clone(nodes), disconnectedNodeIndex, disconnectedNodeIndex+1),
 		logger:           n.logger,
+		timeouts:         n.timeouts,
+		clock:            n.clock,
 	}, nil
 }
 
@@ -78,6 +90,12 @@ func (n *PrivateNetwork) NewChainReorgWithAssertion(
 	return &ChainReorgWithAssertion{ChainReorg: cr}, nil
 }
 
+// Clock returns the clock of the private network. It sets the time of the disconnected
+// node along with the network nodes, so that both chains move in time.
+func (c *ChainReorg) Clock() *Clock {
+	return c.clock
+}
+
 // DisconnectNode disconnects a node from the network.
 func (c *ChainReorg) DisconnectNode(ctx context.Context) (Node, error) {
 	c.logger.Info(
@@ -105,7 +123,8 @@ func (c *ChainReorg) DisconnectNode(ctx context.Context) (Node, error) {
 func (c *ChainReorg) SendTransactionOnNetwork(
 	ctx context.Context,
 	receiverAddress string,
-	amount float64,
+	amount Amount,
+	opts SendOptions,
 ) (string, error) {
 	if !c.disconnected {
 		return "", ErrChainReorgMustDisconnectNodeFirst
@@ -121,7 +140,7 @@ func (c *ChainReorg) SendTransactionOnNetwork(
 		amount,
 	)
 
-	hash, err := c.networkNodes[0].RPCClient().SendToAddress(ctx, receiverAddress, amount)
+	hash, err := c.networkNodes[0].RPCClient().SendToAddress(ctx, receiverAddress, amount, opts)
 	if err != nil {
 		return "", fmt.Errorf("send to address: %w", err)
 	}
@@ -141,7 +160,8 @@ func (c *ChainReorg) SendTransactionOnNetwork(
 func (c *ChainReorg) SendTransactionOnDisconnectedNode(
 	ctx context.Context,
 	receiverAddress string,
-	amount float64,
+	amount Amount,
+	opts SendOptions,
 ) (string, error) {
 	if !c.disconnected {
 		return "", ErrChainReorgMustDisconnectNodeFirst
@@ -157,7 +177,7 @@ func (c *ChainReorg) SendTransactionOnDisconnectedNode(
 		amount,
 	)
 
-	hash, err := c.disconnectedNode.RPCClient().SendToAddress(ctx, receiverAddress, amount)
+	hash, err := c.disconnectedNode.RPCClient().SendToAddress(ctx, receiverAddress, amount, opts)
 	if err != nil {
 		return "", fmt.Errorf("send to address: %w", err)
 	}
@@ -297,7 +317,7 @@ func (c *ChainReorgWithAssertion) DisconnectNode(ctx context.Context) (Node, err
 	t := time.NewTicker(tickEvery)
 	defer t.Stop()
 
-	ctxTimeout, cancel := context.WithTimeout(ctx, time.Second)
+	ctxTimeout, cancel := context.WithTimeout(ctx, c.timeouts.assertion)
 	defer cancel()
 
 	var lastConnectionCount int
@@ -326,11 +346,16 @@ loop:
 
 	eg, egCtx := errgroup.WithContext(ctx)
 
-	expectedConnectionCount := len(c.networkNodes) - 1
-
 	for _, n := range c.networkNodes {
 		n := n
 
+		// the network nodes are expected to keep all their topology peers,
+		// except for the disconnected node.
+		expectedConnectionCount := len(slices.DeleteFunc(
+			n.pn.peersOf(n.id),
+			func(peer Node) bool { return peer.id == disconnectedNode.id },
+		))
+
 		eg.Go(func() error {
 			cc, err := n.RPCClient().GetConnectionCount(egCtx)
 			if err != nil {
@@ -364,9 +389,10 @@ loop:
 func (c *ChainReorgWithAssertion) SendTransactionOnNetwork(
 	ctx context.Context,
 	receiverAddress string,
-	amount float64,
+	amount Amount,
+	opts SendOptions,
 ) (string, error) {
-	hash, err := c.ChainReorg.SendTransactionOnNetwork(ctx, receiverAddress, amount)
+	hash, err := c.ChainReorg.SendTransactionOnNetwork(ctx, receiverAddress, amount, opts)
 	if err != nil {
 		return "", fmt.Errorf("send transaction on network: %w", err)
 	}
@@ -392,9 +418,10 @@ func (c *ChainReorgWithAssertion) SendTransactionOnNetwork(
 func (c *ChainReorgWithAssertion) SendTransactionOnDisconnectedNode(
 	ctx context.Context,
 	receiverAddress string,
-	amount float64,
+	amount Amount,
+	opts SendOptions,
 ) (string, error) {
-	hash, err := c.ChainReorg.SendTransactionOnDisconnectedNode(ctx, receiverAddress, amount)
+	hash, err := c.ChainReorg.SendTransactionOnDisconnectedNode(ctx, receiverAddress, amount, opts)
 	if err != nil {
 		return "", fmt.Errorf("send transaction on network: %w", err)
 	}
@@ -428,9 +455,7 @@ func (c *ChainReorgWithAssertion) MineBlocksOnNetwork(
 
 	bestBlockHash := blockHashes[len(blockHashes)-1]
 
-	const hardTimeout = 5 * time.Second
-
-	ctxTimeout, cancel := context.WithTimeout(ctx, hardTimeout)
+	ctxTimeout, cancel := context.WithTimeout(ctx, c.timeouts.sync)
 	defer cancel()
 
 	if err := c.networkNodes.Sync(ctxTimeout, bestBlockHash); err != nil {
@@ -463,7 +488,10 @@ func (c *ChainReorgWithAssertion) MineBlocksOnDisconnectedNode(
 	bestBlockHash := blockHashes[len(blockHashes)-1]
 
 	if !c.disconnected {
-		if err := c.networkNodes.Sync(ctx, bestBlockHash); err != nil {
+		ctxTimeout, cancel := context.WithTimeout(ctx, c.timeouts.sync)
+		defer cancel()
+
+		if err := c.networkNodes.Sync(ctxTimeout, bestBlockHash); err != nil {
 			return nil, fmt.Errorf("sync network nodes to %s: %w", bestBlockHash, err)
 		}
 	}
@@ -519,9 +547,7 @@ func (c *ChainReorgWithAssertion) ReconnectNode(ctx context.Context) error {
 			return fmt.Errorf("get disconnected node best block Hash: %w", err)
 		}
 
-		const timeout = 5 * time.Second
-
-		ctxTimeout, cancel := context.WithTimeout(ctx, timeout)
+		ctxTimeout, cancel := context.WithTimeout(ctx, c.timeouts.sync)
 		defer cancel()
 
 		if err := c.networkNodes.Sync(ctxTimeout, blockHash); err != nil {
@@ -535,9 +561,7 @@ func (c *ChainReorgWithAssertion) ReconnectNode(ctx context.Context) error {
 			return fmt.Errorf("get network best block Hash: %w", err)
 		}
 
-		const timeout = 5 * time.Second
-
-		ctxTimeout, cancel := context.WithTimeout(ctx, timeout)
+		ctxTimeout, cancel := context.WithTimeout(ctx, c.timeouts.sync)
 		defer cancel()
 
 		if err := (Nodes{c.disconnectedNode}.Sync(ctxTimeout, blockHash)); err != nil {
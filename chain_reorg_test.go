@@ -238,7 +238,8 @@ func TestChainReorg(t *testing.T) {
 		type args struct {
 			ctx             context.Context
 			receiverAddress string
-			amount          float64
+			amount          privatebtc.Amount
+			opts            privatebtc.SendOptions
 		}
 
 		tests := map[string]struct {
@@ -260,10 +261,15 @@ func TestChainReorg(t *testing.T) {
 						c := newChainReorgSuccessRPCClient(peerCount)
 
 						c.SendToAddressFunc = func(
-							context.Context,
-							string,
-							float64,
+							_ context.Context,
+							_ string,
+							_ privatebtc.Amount,
+							opts privatebtc.SendOptions,
 						) (string, error) {
+							if opts != (privatebtc.SendOptions{FeeRate: 5, Replaceable: true}) {
+								return "", assert.AnError
+							}
+
 							return "Hash", nil
 						}
 
@@ -292,6 +298,7 @@ func TestChainReorg(t *testing.T) {
 					ctx:             ctx,
 					receiverAddress: "addr",
 					amount:          1,
+					opts:            privatebtc.SendOptions{FeeRate: 5, Replaceable: true},
 				},
 				newChainReorgFunc: newChainReorgWithDisconnect,
 				assertErr:         require.NoError,
@@ -332,7 +339,8 @@ func TestChainReorg(t *testing.T) {
 						c.SendToAddressFunc = func(
 							context.Context,
 							string,
-							float64,
+							privatebtc.Amount,
+							privatebtc.SendOptions,
 						) (string, error) {
 							return "", assert.AnError
 						}
@@ -367,7 +375,8 @@ func TestChainReorg(t *testing.T) {
 						c.SendToAddressFunc = func(
 							context.Context,
 							string,
-							float64,
+							privatebtc.Amount,
+							privatebtc.SendOptions,
 						) (string, error) {
 							return "Hash", nil
 						}
@@ -407,7 +416,8 @@ func TestChainReorg(t *testing.T) {
 						c.SendToAddressFunc = func(
 							context.Context,
 							string,
-							float64,
+							privatebtc.Amount,
+							privatebtc.SendOptions,
 						) (string, error) {
 							return "Hash", nil
 						}
@@ -462,7 +472,8 @@ func TestChainReorg(t *testing.T) {
 						c.SendToAddressFunc = func(
 							context.Context,
 							string,
-							float64,
+							privatebtc.Amount,
+							privatebtc.SendOptions,
 						) (string, error) {
 							return "Hash", nil
 						}
@@ -513,6 +524,7 @@ func TestChainReorg(t *testing.T) {
 					test.args.ctx,
 					test.args.receiverAddress,
 					test.args.amount,
+					test.args.opts,
 				)
 				test.assertErr(t, err)
 
@@ -526,7 +538,8 @@ func TestChainReorg(t *testing.T) {
 		type args struct {
 			ctx             context.Context
 			receiverAddress string
-			amount          float64
+			amount          privatebtc.Amount
+			opts            privatebtc.SendOptions
 		}
 
 		tests := map[string]struct {
@@ -550,7 +563,8 @@ func TestChainReorg(t *testing.T) {
 						disconenctedNodeRPCClient.SendToAddressFunc = func(
 							context.Context,
 							string,
-							float64,
+							privatebtc.Amount,
+							privatebtc.SendOptions,
 						) (string, error) {
 							return "Hash", nil
 						}
@@ -622,7 +636,8 @@ func TestChainReorg(t *testing.T) {
 						c.SendToAddressFunc = func(
 							context.Context,
 							string,
-							float64,
+							privatebtc.Amount,
+							privatebtc.SendOptions,
 						) (string, error) {
 							return "", assert.AnError
 						}
@@ -657,7 +672,8 @@ func TestChainReorg(t *testing.T) {
 						c.SendToAddressFunc = func(
 							context.Context,
 							string,
-							float64,
+							privatebtc.Amount,
+							privatebtc.SendOptions,
 						) (string, error) {
 							return "Hash", nil
 						}
@@ -696,7 +712,8 @@ func TestChainReorg(t *testing.T) {
 						disconnectedNodeRPCClient.SendToAddressFunc = func(
 							context.Context,
 							string,
-							float64,
+							privatebtc.Amount,
+							privatebtc.SendOptions,
 						) (string, error) {
 							return "Hash", nil
 						}
@@ -759,6 +776,7 @@ func TestChainReorg(t *testing.T) {
 					test.args.ctx,
 					test.args.receiverAddress,
 					test.args.amount,
+					test.args.opts,
 				)
 				test.assertErr(t, err)
 
@@ -0,0 +1,195 @@
+package privatebtc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// Clock controls the local time of every node of the private network, using the
+// setmocktime RPC. Blocks mined while the clock is set are timestamped with the
+// clock time, which allows testing timelocks, mempool expiry and block time rules.
+// The clock reaches the nodes through their RPC port, so it keeps moving the time of
+// the nodes disconnected from the network, e.g. both sides of a chain reorg.
+type Clock struct {
+	nodes  func() Nodes
+	logger *slog.Logger
+
+	mu  sync.Mutex
+	now time.Time // zero when the nodes use the system time
+}
+
+// newClock creates a clock controlling the nodes returned by the given function,
+// the nodes added to the network later on are covered as well.
+func newClock(nodes func() Nodes, logger *slog.Logger) *Clock {
+	return &Clock{
+		nodes:  nodes,
+		logger: logger,
+	}
+}
+
+// Clock returns the clock of the private network, shared by all its callers.
+func (n *PrivateNetwork) Clock() *Clock {
+	return n.clock
+}
+
+// Now returns the time the nodes are set to, or the system time if the clock
+// is not set.
+func (c *Clock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.nowLocked()
+}
+
+// IsSet reports whether the nodes time is set by the clock.
+func (c *Clock) IsSet() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return !c.now.IsZero()
+}
+
+func (c *Clock) nowLocked() time.Time {
+	if c.now.IsZero() {
+		return time.Now()
+	}
+
+	return c.now
+}
+
+// Set sets the time of every node to the given time.
+// If any node fails to set its time, the nodes are set back to the previous time,
+// so that the nodes time never diverges.
+func (c *Clock) Set(ctx context.Context, t time.Time) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.set(ctx, t.Truncate(time.Second))
+}
+
+// Advance moves the time of every node forward by the given duration, starting
+// from the system time if the clock is not set.
+func (c *Clock) Advance(ctx context.Context, d time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.set(ctx, c.nowLocked().Add(d).Truncate(time.Second))
+}
+
+// Reset sets every node back to the system time.
+func (c *Clock) Reset(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.set(ctx, time.Time{})
+}
+
+// set sets the time of every node, c.mu must be held.
+func (c *Clock) set(ctx context.Context, t time.Time) error {
+	c.logger.Info("🕰️⌛ Setting nodes time", "time", t)
+
+	nodes := c.nodes()
+
+	if err := setMockTime(ctx, nodes, t); err != nil {
+		if rollbackErr := setMockTime(ctx, nodes, c.now); rollbackErr != nil {
+			err = errors.Join(err, fmt.Errorf("rollback: %w", rollbackErr))
+		}
+
+		return err
+	}
+
+	c.now = t
+
+	c.logger.Info("🕰️✅ Successfully set nodes time", "time", t)
+
+	return nil
+}
+
+// syncNode sets the time of the given node to the clock time, it is called for
+// the nodes joining the network so that they accept the blocks mined by the others.
+func (c *Clock) syncNode(ctx context.Context, node Node) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.now.IsZero() {
+		return nil
+	}
+
+	return setMockTime(ctx, Nodes{node}, c.now)
+}
+
+// MineBlocks mines the given number of blocks on the given node, advancing the time
+// of every node by the given interval before each block, so that every block is
+// timestamped interval after the previous one.
+func (c *Clock) MineBlocks(
+	ctx context.Context,
+	miner Node,
+	numBlocks int64,
+	interval time.Duration,
+) ([]string, error) {
+	const (
+		burningAddr = "bcrt1qzlfc3dw3ecjncvkwmwpvs84ejqzp4fr4agghm8"
+	)
+
+	c.logger.Info(
+		"⏹️🕰️⌛ Mine blocks at interval",
+		"miner_node_id",
+		miner.Name(),
+		"num_blocks",
+		numBlocks,
+		"interval",
+		interval,
+	)
+
+	blockHashes := make([]string, 0, numBlocks)
+
+	for i := int64(0); i < numBlocks; i++ {
+		if err := c.Advance(ctx, interval); err != nil {
+			return nil, fmt.Errorf("advance block %d: %w", i, err)
+		}
+
+		hashes, err := miner.RPCClient().GenerateToAddress(ctx, 1, burningAddr)
+		if err != nil {
+			return nil, fmt.Errorf("generate block %d: %w", i, err)
+		}
+
+		blockHashes = append(blockHashes, hashes...)
+	}
+
+	c.logger.Info(
+		"⏹️🕰️✅ Successfully mined blocks at interval",
+		"miner_node_id",
+		miner.Name(),
+		"num_blocks",
+		numBlocks,
+		"block_hashes",
+		blockHashes,
+	)
+
+	return blockHashes, nil
+}
+
+// setMockTime sets the time of the given nodes concurrently.
+func setMockTime(ctx context.Context, nodes Nodes, t time.Time) error {
+	eg, ctx := errgroup.WithContext(ctx)
+
+	for i := range nodes {
+		node := nodes[i]
+
+		eg.Go(func() error {
+			if err := node.RPCClient().SetMockTime(ctx, t); err != nil {
+				return fmt.Errorf("node %d: %w", node.ID(), err)
+			}
+
+			return nil
+		})
+	}
+
+	return eg.Wait()
+}
@@ -0,0 +1,169 @@
+package privatebtc_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/adrianbrad/privatebtc"
+	"github.com/adrianbrad/privatebtc/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// mockTimes records the mock time of every node.
+type mockTimes struct {
+	mu    sync.Mutex
+	times map[int]time.Time
+	mined map[int]int
+
+	// failing is the ID of a node which fails to set its time to failingTime.
+	failing     int
+	failingTime time.Time
+}
+
+func (m *mockTimes) rpcClient(id int) *mock.RPCClient {
+	return &mock.RPCClient{
+		SetMockTimeFunc: func(_ context.Context, t time.Time) error {
+			m.mu.Lock()
+			defer m.mu.Unlock()
+
+			if id == m.failing && t.Equal(m.failingTime) {
+				return errSetMockTime
+			}
+
+			m.times[id] = t
+
+			return nil
+		},
+		GenerateToAddressFunc: func(context.Context, int64, string) ([]string, error) {
+			m.mu.Lock()
+			defer m.mu.Unlock()
+
+			m.mined[id]++
+
+			return []string{m.times[id].Format(time.TimeOnly)}, nil
+		},
+	}
+}
+
+func (m *mockTimes) snapshot() map[int]time.Time {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	times := make(map[int]time.Time, len(m.times))
+	for id, t := range m.times {
+		times[id] = t
+	}
+
+	return times
+}
+
+var errSetMockTime = errors.New("set mock time error")
+
+func TestClock(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	newNetwork := func(t *testing.T, times *mockTimes) *privatebtc.PrivateNetwork {
+		t.Helper()
+
+		mocks := newMockNodes(times.rpcClient)
+
+		pn, err := privatebtc.NewPrivateNetwork(mocks.nodeService(3), mocks.rpcClientFactory(), 3)
+		require.NoError(t, err)
+
+		require.NoError(t, pn.Start(context.Background()))
+
+		return pn
+	}
+
+	t.Run("SetAdvanceReset", func(t *testing.T) {
+		t.Parallel()
+
+		req := require.New(t)
+
+		times := &mockTimes{times: map[int]time.Time{}, mined: map[int]int{}, failing: -1}
+
+		clock := newNetwork(t, times).Clock()
+		req.False(clock.IsSet())
+
+		req.NoError(clock.Set(context.Background(), start.Add(500*time.Millisecond)))
+		req.True(clock.IsSet())
+		req.Equal(start, clock.Now())
+		req.Equal(map[int]time.Time{0: start, 1: start, 2: start}, times.snapshot())
+
+		req.NoError(clock.Advance(context.Background(), time.Hour))
+		req.Equal(start.Add(time.Hour), clock.Now())
+		req.Equal(map[int]time.Time{
+			0: start.Add(time.Hour),
+			1: start.Add(time.Hour),
+			2: start.Add(time.Hour),
+		}, times.snapshot())
+
+		req.NoError(clock.Reset(context.Background()))
+		req.False(clock.IsSet())
+		req.Equal(map[int]time.Time{0: {}, 1: {}, 2: {}}, times.snapshot())
+	})
+
+	t.Run("Rollback", func(t *testing.T) {
+		t.Parallel()
+
+		req := require.New(t)
+
+		times := &mockTimes{
+			times:       map[int]time.Time{},
+			mined:       map[int]int{},
+			failing:     2,
+			failingTime: start.Add(time.Hour),
+		}
+
+		clock := newNetwork(t, times).Clock()
+
+		req.NoError(clock.Set(context.Background(), start))
+
+		err := clock.Advance(context.Background(), time.Hour)
+		req.ErrorIs(err, errSetMockTime)
+
+		req.Equal(start, clock.Now())
+		req.Equal(map[int]time.Time{0: start, 1: start, 2: start}, times.snapshot())
+	})
+
+	t.Run("MineBlocks", func(t *testing.T) {
+		t.Parallel()
+
+		req := require.New(t)
+
+		times := &mockTimes{times: map[int]time.Time{}, mined: map[int]int{}, failing: -1}
+
+		pn := newNetwork(t, times)
+		clock := pn.Clock()
+
+		req.NoError(clock.Set(context.Background(), start))
+
+		blockHashes, err := clock.MineBlocks(context.Background(), pn.Nodes()[1], 3, 10*time.Minute)
+		req.NoError(err)
+
+		req.Equal([]string{"12:10:00", "12:20:00", "12:30:00"}, blockHashes)
+		req.Equal(map[int]int{1: 3}, times.mined)
+		req.Equal(start.Add(30*time.Minute), clock.Now())
+		req.Equal(start.Add(30*time.Minute), times.snapshot()[0])
+	})
+
+	t.Run("ChainReorg", func(t *testing.T) {
+		t.Parallel()
+
+		req := require.New(t)
+
+		times := &mockTimes{times: map[int]time.Time{}, mined: map[int]int{}, failing: -1}
+
+		pn := newNetwork(t, times)
+
+		cr, err := pn.NewChainReorg(0)
+		req.NoError(err)
+
+		req.Same(pn.Clock(), cr.Clock())
+	})
+}
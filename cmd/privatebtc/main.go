@@ -21,7 +21,7 @@ var (
 var rootCMD = &cobra.Command{
 	Use:   "privatebtc",
 	Short: "Start a bitcoin private network with a terminal user interface",
-	Run: func(*cobra.Command, []string) {
+	Run: func(cmd *cobra.Command, _ []string) {
 		const nodes = 3
 
 		loggerHandler := slog.NewTextHandler(os.Stdout, nil)
@@ -40,13 +40,22 @@ var rootCMD = &cobra.Command{
 			slog.String("build_time", time),
 		)
 
-		if err := runTUI(nodes, loggerHandler); err != nil {
+		attachNetworkID, _ := cmd.Flags().GetString("attach")
+
+		if err := runTUI(nodes, attachNetworkID, loggerHandler); err != nil {
 			logger.Error("run error", "err", err)
 		}
 	},
 }
 
 func init() {
+	rootCMD.Flags().String(
+		"attach",
+		"",
+		"attach to the already running network with the given ID instead of starting a new one, "+
+			"the network is left running on exit",
+	)
+
 	rootCMD.AddCommand(envcheckCMD)
 }
 
@@ -8,20 +8,24 @@ import (
 	"os/signal"
 
 	"github.com/adrianbrad/privatebtc"
-	"github.com/adrianbrad/privatebtc/btcsuite"
 	"github.com/adrianbrad/privatebtc/docker/testcontainers"
+	"github.com/adrianbrad/privatebtc/jsonrpc"
 	"github.com/adrianbrad/privatebtc/tview"
 )
 
-func runTUI(nodes int, loggerHandler slog.Handler) error {
+func runTUI(nodes int, attachNetworkID string, loggerHandler slog.Handler) error {
 	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
 	defer cancel()
 
+	if attachNetworkID != "" {
+		return attachTUI(ctx, attachNetworkID, loggerHandler)
+	}
+
 	btcpn, err := privatebtc.NewPrivateNetwork(
 		&testcontainers.NodeService{
 			SlogHandler: loggerHandler,
 		},
-		btcsuite.RPCClientFactory{},
+		jsonrpc.RPCClientFactory{},
 		nodes,
 		privatebtc.WithWallet("tui"),
 		privatebtc.WithSlogHandler(loggerHandler),
@@ -30,6 +34,8 @@ func runTUI(nodes int, loggerHandler slog.Handler) error {
 		return fmt.Errorf("create bitcoin private network error: %w", err)
 	}
 
+	slog.New(loggerHandler).Info("starting bitcoin private network", "network_id", btcpn.ID())
+
 	if err := btcpn.Start(ctx); err != nil {
 		return fmt.Errorf("start bitcoin private network error: %w", err)
 	}
@@ -47,3 +53,27 @@ func runTUI(nodes int, loggerHandler slog.Handler) error {
 
 	return nil
 }
+
+// attachTUI runs the TUI for an already running network, the network is left
+// running once the TUI exits.
+func attachTUI(ctx context.Context, networkID string, loggerHandler slog.Handler) error {
+	btcpn, err := privatebtc.Attach(
+		ctx,
+		&testcontainers.NodeService{
+			SlogHandler: loggerHandler,
+		},
+		jsonrpc.RPCClientFactory{},
+		networkID,
+		privatebtc.WithWallet("tui"),
+		privatebtc.WithSlogHandler(loggerHandler),
+	)
+	if err != nil {
+		return fmt.Errorf("attach to bitcoin private network error: %w", err)
+	}
+
+	if err := tview.NewTUI(btcpn, version).Run(); err != nil {
+		return fmt.Errorf("run tui error: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package privatebtc
+
+import "time"
+
+// Descriptor is an output descriptor of a descriptor wallet.
+type Descriptor struct {
+	// Desc is the descriptor string, including its checksum.
+	Desc      string
+	Timestamp time.Time
+	// Active reports whether the descriptor is used to generate new addresses.
+	Active bool
+	// Internal reports whether the descriptor is used to generate change addresses.
+	Internal bool
+	// Range is the range of the derivation indexes of a ranged descriptor,
+	// nil for a descriptor which is not ranged.
+	Range *[2]int
+	// Next is the next index used to generate addresses from a ranged descriptor.
+	Next int
+}
+
+// ImportDescriptorRequest is a request to import a descriptor into a descriptor wallet.
+type ImportDescriptorRequest struct {
+	// Desc is the descriptor string, including its checksum.
+	Desc string
+	// Active sets the descriptor as the one used to generate new addresses,
+	// only ranged descriptors can be active.
+	Active bool
+	// Internal sets the descriptor as the one used to generate change addresses.
+	Internal bool
+	// Range is the range of the derivation indexes imported from a ranged descriptor,
+	// the node default range is used when nil.
+	Range *[2]int
+	// Label is the label of the addresses of a descriptor which is not active.
+	Label string
+	// Timestamp is the time from which the chain is rescanned for the descriptor
+	// transactions, the chain is not rescanned when zero.
+	Timestamp time.Time
+}
+
+// DescriptorInfo is the analysis of a descriptor.
+type DescriptorInfo struct {
+	// Descriptor is the canonical form of the descriptor, including its checksum.
+	Descriptor     string
+	Checksum       string
+	IsRange        bool
+	IsSolvable     bool
+	HasPrivateKeys bool
+}
+
+// AddressInfo is the information a wallet has about one of its addresses.
+type AddressInfo struct {
+	Address string
+	IsMine  bool
+	// Descriptor is the public descriptor of the address, including its checksum.
+	Descriptor string
+	// HDKeyPath is the derivation path of the address key, e.g. m/84h/1h/0h/0/5,
+	// empty for a key which is not derived.
+	HDKeyPath string
+}
+
+// Multisig is a multisig address.
+type Multisig struct {
+	Address string
+	// RedeemScript is the hex encoded multisig script.
+	RedeemScript string
+	// Descriptor is the descriptor of the address.
+	Descriptor string
+}
@@ -6,4 +6,8 @@
 // different Go Bitcoin RPC Clients.
 // A chain reorganisation manager is implemented, it streamlines the process of creating
 // chain reorganisations.
+// Networks can also be split into several isolated partitions that are later healed,
+// in order to reproduce minority/majority split scenarios.
+// The chain state of a network can be snapshotted, so that large fixtures are restored
+// instead of being rebuilt for every test.
 package privatebtc
@@ -1,4 +1,21 @@
 package docker
 
+// BitcoinImageRepository is the name of the docker repository of the bitcoin node Image.
+const BitcoinImageRepository = "dobtc/bitcoin"
+
 // BitcoinImage is the name of the docker Image used for the bitcoin node.
-const BitcoinImage = "dobtc/bitcoin:latest"
+const BitcoinImage = BitcoinImageRepository + ":latest"
+
+// BitcoinImageWithVersion returns the name of the docker Image used for a bitcoin node
+// running the given bitcoin client version.
+// BitcoinImage is returned if the version is empty.
+func BitcoinImageWithVersion(version string) string {
+	if version == "" {
+		return BitcoinImage
+	}
+
+	return BitcoinImageRepository + ":" + version
+}
+
+// BitcoinDataDir is the bitcoin node data directory inside the docker Image.
+const BitcoinDataDir = "/home/bitcoin/.bitcoin"
@@ -0,0 +1,7 @@
+package docker
+
+// NetworkName returns the name of the docker network of the private network with the
+// given ID.
+func NetworkName(networkID string) string {
+	return "privatebtc_" + networkID
+}
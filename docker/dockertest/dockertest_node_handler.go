@@ -1,24 +1,29 @@
 package dockertest
 
 import (
+	"context"
 	"fmt"
+	"io"
 	"net"
 
 	"github.com/adrianbrad/privatebtc"
+	pbtcdocker "github.com/adrianbrad/privatebtc/docker"
 	"github.com/ory/dockertest/v3"
+	"github.com/ory/dockertest/v3/docker"
 )
 
 var _ privatebtc.NodeHandler = (*NodeHandler)(nil)
 
 // NodeHandler represents a docker container.
 type NodeHandler struct {
+	pool        *dockertest.Pool
 	res         *dockertest.Resource
 	containerIP string
 	hostRPCPort string
 	name        string
 }
 
-func newNodeHandler(res *dockertest.Resource) (*NodeHandler, error) {
+func newNodeHandler(pool *dockertest.Pool, res *dockertest.Resource) (*NodeHandler, error) {
 	host := res.GetHostPort(privatebtc.RPCRegtestDefaultPort + "/tcp")
 
 	_, hostRPCPort, err := net.SplitHostPort(host)
@@ -28,7 +33,17 @@ func newNodeHandler(res *dockertest.Resource) (*NodeHandler, error) {
 
 	containerIP := res.Container.NetworkSettings.IPAddress
 
+	// containers attached to a user-defined network only have an IP in that network.
+	if containerIP == "" {
+		for _, network := range res.Container.NetworkSettings.Networks {
+			containerIP = network.IPAddress
+
+			break
+		}
+	}
+
 	return &NodeHandler{
+		pool:        pool,
 		res:         res,
 		hostRPCPort: hostRPCPort,
 		containerIP: containerIP,
@@ -55,3 +70,21 @@ func (n NodeHandler) Name() string {
 func (n NodeHandler) Close() error {
 	return n.res.Close()
 }
+
+// ExportDatadir returns a tar archive of the bitcoin node data directory.
+func (n NodeHandler) ExportDatadir(ctx context.Context) (io.ReadCloser, error) {
+	r, w := io.Pipe()
+
+	go func() {
+		w.CloseWithError(n.pool.Client.DownloadFromContainer(
+			n.res.Container.ID,
+			docker.DownloadFromContainerOptions{
+				OutputStream: w,
+				Path:         pbtcdocker.BitcoinDataDir,
+				Context:      ctx,
+			},
+		))
+	}()
+
+	return r, nil
+}
@@ -6,6 +6,9 @@ import (
 	"fmt"
 	"io"
 	"log/slog"
+	"os"
+	"path"
+	"strconv"
 	"strings"
 	"sync"
 
@@ -35,18 +38,14 @@ func (s *NodeService) CreateNodes(
 ) ([]privatebtc.NodeHandler, error) {
 	s.initOnce.Do(s.init)
 
-	dockerHost, err := pbtcdocker.GetDockerHost()
+	pool, err := newPool()
 	if err != nil {
-		return nil, fmt.Errorf("get docker host: %w", err)
+		return nil, err
 	}
 
-	pool, err := dockertest.NewPool(dockerHost)
+	subnets, err := ensureNetworks(pool, nodeRequests)
 	if err != nil {
-		return nil, fmt.Errorf("create docker pool: %w", err)
-	}
-
-	if err := pool.Client.Ping(); err != nil {
-		return nil, fmt.Errorf("ping docker: %w", err)
+		return nil, err
 	}
 
 	containers := make([]*dockertest.Resource, len(nodeRequests))
@@ -57,40 +56,47 @@ func (s *NodeService) CreateNodes(
 		i, nodeReq := i, nodeReq
 
 		eg.Go(func() error {
-			containerName := fmt.Sprintf("privatebtc_node_%d", i)
+			containerName := nodeReq.Name
+			networkName := pbtcdocker.NetworkName(nodeReq.NetworkID)
+
+			nodeReq.RPCAllowIP = subnets[nodeReq.NetworkID]
 
 			s.logger.Info("🐳⌛ Creating container", "name", containerName)
 
-			sub := strings.Split(pbtcdocker.BitcoinImage, ":")
+			sub := strings.Split(pbtcdocker.BitcoinImageWithVersion(nodeReq.BitcoinClientVersion), ":")
 
 			imageName := sub[0]
 			imageTag := sub[1]
 
-			res, err := pool.RunWithOptions(
-				&dockertest.RunOptions{
-					Name:       fmt.Sprintf("privatebtc_node_%d", i),
-					Repository: imageName,
-					Tag:        imageTag,
-					Cmd: []string{
-						"-regtest=1",
-						"-rpcallowip=172.17.0.0/16", // allow requests coming from the docker host
-						"-rpcbind=0.0.0.0",
-						"-dnsseed=0",
-						"-txindex",
-						fmt.Sprintf("-rpcauth=%s", nodeReq.RPCAuth),
-						fmt.Sprintf("-fallbackfee=%f", nodeReq.FallbackFee),
-						// "blocksonly=1", // use this flag in order to disable mempool and
-						// cause walletnotify to trigger when transaction has only 1 confirmation
-					},
-					ExposedPorts: []string{
-						privatebtc.RPCRegtestDefaultPort + "/tcp",
-					},
-				},
-				func(hostConfig *docker.HostConfig) {
-					hostConfig.AutoRemove = true
-					hostConfig.RestartPolicy = docker.RestartPolicy{Name: "no"}
+			runOpts := &dockertest.RunOptions{
+				Name:       containerName,
+				Repository: imageName,
+				Tag:        imageTag,
+				Cmd:        nodeReq.BitcoindArgs(),
+				Labels:     nodeReq.Labels(),
+				NetworkID:  networkName,
+				ExposedPorts: []string{
+					privatebtc.RPCRegtestDefaultPort + "/tcp",
 				},
+			}
+
+			hostConfig := func(hostConfig *docker.HostConfig) {
+				hostConfig.NetworkMode = networkName
+				hostConfig.AutoRemove = true
+				hostConfig.RestartPolicy = docker.RestartPolicy{Name: "no"}
+			}
+
+			var (
+				res *dockertest.Resource
+				err error
 			)
+
+			if nodeReq.SnapshotPath == "" {
+				res, err = pool.RunWithOptions(runOpts, hostConfig)
+			} else {
+				res, err = runWithSnapshot(ctx, pool, runOpts, hostConfig, nodeReq.SnapshotPath)
+			}
+
 			if err != nil {
 				return fmt.Errorf("run with options: %w", err)
 			}
@@ -119,7 +125,7 @@ func (s *NodeService) CreateNodes(
 	conts := make([]privatebtc.NodeHandler, len(containers))
 
 	for i, res := range containers {
-		conts[i], err = newNodeHandler(res)
+		conts[i], err = newNodeHandler(pool, res)
 		if err != nil {
 			err = fmt.Errorf("new container: %w", err)
 
@@ -137,6 +143,223 @@ func (s *NodeService) CreateNodes(
 	return conts, nil
 }
 
+// ListNodes returns the running bitcoin node containers of the network with the given ID.
+func (s *NodeService) ListNodes(
+	_ context.Context,
+	networkID string,
+) (map[int]privatebtc.NodeHandler, error) {
+	s.initOnce.Do(s.init)
+
+	pool, err := newPool()
+	if err != nil {
+		return nil, err
+	}
+
+	containers, err := pool.Client.ListContainers(docker.ListContainersOptions{
+		Filters: map[string][]string{
+			"label": {privatebtc.NetworkIDLabel + "=" + networkID},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list containers: %w", err)
+	}
+
+	nodeHandlers := make(map[int]privatebtc.NodeHandler, len(containers))
+
+	for _, c := range containers {
+		nodeID, err := strconv.Atoi(c.Labels[privatebtc.NodeIDLabel])
+		if err != nil {
+			return nil, fmt.Errorf("parse container %s node id label: %w", c.ID, err)
+		}
+
+		res, ok := pool.ContainerByName("^" + c.Names[0] + "$")
+		if !ok {
+			return nil, fmt.Errorf("container %q: %w", c.Names[0], errContainerNotFound)
+		}
+
+		nodeHandlers[nodeID], err = newNodeHandler(pool, res)
+		if err != nil {
+			return nil, fmt.Errorf("node %d handler: %w", nodeID, err)
+		}
+	}
+
+	return nodeHandlers, nil
+}
+
+// RemoveNetwork removes the docker network of the network with the given ID.
+// A docker network that does not exist is ignored.
+func (s *NodeService) RemoveNetwork(_ context.Context, networkID string) error {
+	pool, err := newPool()
+	if err != nil {
+		return err
+	}
+
+	var noSuchNetworkErr *docker.NoSuchNetwork
+
+	if err := pool.Client.RemoveNetwork(pbtcdocker.NetworkName(networkID)); err != nil &&
+		!errors.As(err, &noSuchNetworkErr) {
+		return fmt.Errorf("remove docker network: %w", err)
+	}
+
+	return nil
+}
+
+// ensureNetworks creates the docker networks of the given node requests, if they do
+// not exist yet, and returns their subnets indexed by network ID.
+func ensureNetworks(
+	pool *dockertest.Pool,
+	nodeRequests []privatebtc.CreateNodeRequest,
+) (map[string]string, error) {
+	subnets := make(map[string]string)
+
+	for _, nodeReq := range nodeRequests {
+		if _, ok := subnets[nodeReq.NetworkID]; ok {
+			continue
+		}
+
+		subnet, err := ensureNetwork(pool, nodeReq.NetworkID)
+		if err != nil {
+			return nil, fmt.Errorf("ensure network %q: %w", nodeReq.NetworkID, err)
+		}
+
+		subnets[nodeReq.NetworkID] = subnet
+	}
+
+	return subnets, nil
+}
+
+func ensureNetwork(pool *dockertest.Pool, networkID string) (string, error) {
+	name := pbtcdocker.NetworkName(networkID)
+
+	var noSuchNetworkErr *docker.NoSuchNetwork
+
+	network, err := pool.Client.NetworkInfo(name)
+	if errors.As(err, &noSuchNetworkErr) {
+		_, err = pool.Client.CreateNetwork(docker.CreateNetworkOptions{
+			Name:   name,
+			Driver: "bridge",
+			Labels: map[string]string{privatebtc.NetworkIDLabel: networkID},
+		})
+		// the network might have been created in the meantime.
+		if err != nil && !errors.Is(err, docker.ErrNetworkAlreadyExists) {
+			return "", fmt.Errorf("create docker network: %w", err)
+		}
+
+		network, err = pool.Client.NetworkInfo(name)
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("inspect docker network: %w", err)
+	}
+
+	if len(network.IPAM.Config) == 0 {
+		return "", fmt.Errorf("docker network %q: %w", name, errSubnetNotFound)
+	}
+
+	return network.IPAM.Config[0].Subnet, nil
+}
+
+func newPool() (*dockertest.Pool, error) {
+	dockerHost, err := pbtcdocker.GetDockerHost()
+	if err != nil {
+		return nil, fmt.Errorf("get docker host: %w", err)
+	}
+
+	pool, err := dockertest.NewPool(dockerHost)
+	if err != nil {
+		return nil, fmt.Errorf("create docker pool: %w", err)
+	}
+
+	if err := pool.Client.Ping(); err != nil {
+		return nil, fmt.Errorf("ping docker: %w", err)
+	}
+
+	return pool, nil
+}
+
+// runWithSnapshot creates the container, copies the data directory archive into it
+// and only then starts it, so that bitcoind starts from the snapshot.
+func runWithSnapshot(
+	ctx context.Context,
+	pool *dockertest.Pool,
+	opts *dockertest.RunOptions,
+	hostConfigModifier func(*docker.HostConfig),
+	snapshotPath string,
+) (*dockertest.Resource, error) {
+	archive, err := os.Open(snapshotPath)
+	if err != nil {
+		return nil, fmt.Errorf("open snapshot: %w", err)
+	}
+
+	defer archive.Close()
+
+	exposedPorts := make(map[docker.Port]struct{}, len(opts.ExposedPorts))
+
+	for _, port := range opts.ExposedPorts {
+		exposedPorts[docker.Port(port)] = struct{}{}
+	}
+
+	hostConfig := &docker.HostConfig{PublishAllPorts: true}
+
+	hostConfigModifier(hostConfig)
+
+	var networkingConfig *docker.NetworkingConfig
+
+	if opts.NetworkID != "" {
+		networkingConfig = &docker.NetworkingConfig{
+			EndpointsConfig: map[string]*docker.EndpointConfig{opts.NetworkID: {}},
+		}
+	}
+
+	cont, err := pool.Client.CreateContainer(docker.CreateContainerOptions{
+		Name:             opts.Name,
+		NetworkingConfig: networkingConfig,
+		Config: &docker.Config{
+			Image:        opts.Repository + ":" + opts.Tag,
+			Cmd:          opts.Cmd,
+			Labels:       opts.Labels,
+			ExposedPorts: exposedPorts,
+		},
+		HostConfig: hostConfig,
+		Context:    ctx,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create container: %w", err)
+	}
+
+	removeContainer := func() error {
+		return pool.Client.RemoveContainer(docker.RemoveContainerOptions{
+			ID:            cont.ID,
+			Force:         true,
+			RemoveVolumes: true,
+		})
+	}
+
+	if err := pool.Client.UploadToContainer(cont.ID, docker.UploadToContainerOptions{
+		InputStream: archive,
+		Path:        path.Dir(pbtcdocker.BitcoinDataDir),
+		Context:     ctx,
+	}); err != nil {
+		return nil, errors.Join(fmt.Errorf("upload snapshot: %w", err), removeContainer())
+	}
+
+	if err := pool.Client.StartContainerWithContext(cont.ID, nil, ctx); err != nil {
+		return nil, errors.Join(fmt.Errorf("start container: %w", err), removeContainer())
+	}
+
+	res, ok := pool.ContainerByName("^/" + opts.Name + "$")
+	if !ok {
+		return nil, errors.Join(fmt.Errorf("container %q: %w", opts.Name, errContainerNotFound), removeContainer())
+	}
+
+	return res, nil
+}
+
+var (
+	errContainerNotFound = errors.New("container not found")
+	errSubnetNotFound    = errors.New("subnet not found")
+)
+
 func (s *NodeService) init() {
 	s.logger = slog.New(slog.NewTextHandler(io.Discard, nil))
 
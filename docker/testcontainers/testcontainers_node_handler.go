@@ -2,9 +2,12 @@ package testcontainers
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 
 	"github.com/adrianbrad/privatebtc"
+	"github.com/adrianbrad/privatebtc/docker"
 	"github.com/docker/go-connections/nat"
 	"github.com/testcontainers/testcontainers-go"
 	"golang.org/x/sync/errgroup"
@@ -96,3 +99,35 @@ func (c NodeHandler) Name() string {
 func (c NodeHandler) Close() error {
 	return c.cont.Terminate(context.Background())
 }
+
+// ExportDatadir returns a tar archive of the bitcoin node data directory.
+func (c NodeHandler) ExportDatadir(ctx context.Context) (io.ReadCloser, error) {
+	dockerClient, err := docker.NewClient()
+	if err != nil {
+		return nil, fmt.Errorf("new docker client: %w", err)
+	}
+
+	archive, _, err := dockerClient.CopyFromContainer(ctx, c.cont.GetContainerID(), docker.BitcoinDataDir)
+	if err != nil {
+		return nil, errors.Join(
+			fmt.Errorf("copy from container: %w", err),
+			dockerClient.Close(),
+		)
+	}
+
+	return readCloser{
+		Reader: archive,
+		close: func() error {
+			return errors.Join(archive.Close(), dockerClient.Close())
+		},
+	}, nil
+}
+
+type readCloser struct {
+	io.Reader
+	close func() error
+}
+
+func (r readCloser) Close() error {
+	return r.close()
+}
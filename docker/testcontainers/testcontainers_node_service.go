@@ -2,15 +2,24 @@ package testcontainers
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"log"
 	"log/slog"
+	"os"
+	"path"
+	"strconv"
+	"strings"
 	"sync"
 
 	"github.com/adrianbrad/privatebtc"
 	"github.com/adrianbrad/privatebtc/docker"
+	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/client"
+	"github.com/docker/docker/errdefs"
 	"github.com/testcontainers/testcontainers-go"
 	"github.com/testcontainers/testcontainers-go/wait"
 	"golang.org/x/sync/errgroup"
@@ -35,32 +44,43 @@ func (s *NodeService) CreateNodes(
 ) ([]privatebtc.NodeHandler, error) {
 	s.initOnce.Do(s.init)
 
+	subnets, err := ensureNetworks(ctx, nodeRequests)
+	if err != nil {
+		return nil, err
+	}
+
 	reqs := make([]testcontainers.GenericContainerRequest, len(nodeRequests))
 
 	for i, nodeReq := range nodeRequests {
+		nodeReq.RPCAllowIP = subnets[nodeReq.NetworkID]
+
+		networkName := docker.NetworkName(nodeReq.NetworkID)
+
+		var lifecycleHooks []testcontainers.ContainerLifecycleHooks
+
+		if nodeReq.SnapshotPath != "" {
+			lifecycleHooks = []testcontainers.ContainerLifecycleHooks{{
+				PreStarts: []testcontainers.ContainerHook{restoreSnapshot(nodeReq.SnapshotPath)},
+			}}
+		}
+
 		reqs[i] = testcontainers.GenericContainerRequest{
 			ContainerRequest: testcontainers.ContainerRequest{
-				Image: docker.BitcoinImage,
+				Image: docker.BitcoinImageWithVersion(nodeReq.BitcoinClientVersion),
 				ExposedPorts: []string{
 					privatebtc.RPCRegtestDefaultPort + "/tcp",
 				},
-				Cmd: []string{
-					"-regtest=1",
-					"-rpcallowip=172.17.0.0/16", // allow requests coming from the docker host
-					"-rpcbind=0.0.0.0",
-					"-dnsseed=0",
-					"-txindex",
-					fmt.Sprintf("-rpcauth=%s", nodeReq.RPCAuth),
-					fmt.Sprintf("-fallbackfee=%f", nodeReq.FallbackFee),
-					// "blocksonly=1", // use this flag in order to disable mempool and
-					// cause walletnotify to trigger when transaction has only 1 confirmation
-				},
+				Cmd:        nodeReq.BitcoindArgs(),
 				WaitingFor: wait.ForLog("init message: Done loading"),
-				Name:       fmt.Sprintf("privatebtc_node_%d", i),
+				Name:       nodeReq.Name,
+				Networks:   []string{networkName},
 				HostConfigModifier: func(config *container.HostConfig) {
+					config.NetworkMode = container.NetworkMode(networkName)
 					config.AutoRemove = true
 					config.RestartPolicy = container.RestartPolicy{Name: "no"}
 				},
+				LifecycleHooks: lifecycleHooks,
+				Labels:         nodeReq.Labels(),
 			},
 			Started: true,
 			Logger:  s.testcontLogger,
@@ -102,6 +122,170 @@ func (s *NodeService) CreateNodes(
 	return conts, nil
 }
 
+// ListNodes returns the running bitcoin node containers of the network with the given ID.
+// Containers created by testcontainers are removed by the Ryuk reaper once the process
+// that created them exits, set TESTCONTAINERS_RYUK_DISABLED=true in order to keep the
+// network running and attach to it later on.
+func (s *NodeService) ListNodes(
+	ctx context.Context,
+	networkID string,
+) (map[int]privatebtc.NodeHandler, error) {
+	s.initOnce.Do(s.init)
+
+	dockerClient, err := docker.NewClient()
+	if err != nil {
+		return nil, fmt.Errorf("new docker client: %w", err)
+	}
+
+	defer dockerClient.Close()
+
+	containers, err := dockerClient.ContainerList(ctx, types.ContainerListOptions{
+		Filters: filters.NewArgs(filters.Arg("label", privatebtc.NetworkIDLabel+"="+networkID)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list containers: %w", err)
+	}
+
+	nodeHandlers := make(map[int]privatebtc.NodeHandler, len(containers))
+
+	for _, c := range containers {
+		nodeID, err := strconv.Atoi(c.Labels[privatebtc.NodeIDLabel])
+		if err != nil {
+			return nil, fmt.Errorf("parse container %s node id label: %w", c.ID, err)
+		}
+
+		testCont, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+			ContainerRequest: testcontainers.ContainerRequest{
+				Name: strings.TrimPrefix(c.Names[0], "/"),
+			},
+			Started: true,
+			Reuse:   true,
+			Logger:  s.testcontLogger,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("reuse container %s: %w", c.ID, err)
+		}
+
+		nodeHandlers[nodeID], err = newNodeHandler(ctx, testCont)
+		if err != nil {
+			return nil, fmt.Errorf("node %d handler: %w", nodeID, err)
+		}
+	}
+
+	return nodeHandlers, nil
+}
+
+// RemoveNetwork removes the docker network of the network with the given ID.
+// A docker network that does not exist is ignored.
+func (s *NodeService) RemoveNetwork(ctx context.Context, networkID string) error {
+	dockerClient, err := docker.NewClient()
+	if err != nil {
+		return fmt.Errorf("new docker client: %w", err)
+	}
+
+	defer dockerClient.Close()
+
+	if err := dockerClient.NetworkRemove(ctx, docker.NetworkName(networkID)); err != nil &&
+		!errdefs.IsNotFound(err) {
+		return fmt.Errorf("remove docker network: %w", err)
+	}
+
+	return nil
+}
+
+// ensureNetworks creates the docker networks of the given node requests, if they do
+// not exist yet, and returns their subnets indexed by network ID.
+func ensureNetworks(
+	ctx context.Context,
+	nodeRequests []privatebtc.CreateNodeRequest,
+) (map[string]string, error) {
+	dockerClient, err := docker.NewClient()
+	if err != nil {
+		return nil, fmt.Errorf("new docker client: %w", err)
+	}
+
+	defer dockerClient.Close()
+
+	subnets := make(map[string]string)
+
+	for _, nodeReq := range nodeRequests {
+		if _, ok := subnets[nodeReq.NetworkID]; ok {
+			continue
+		}
+
+		subnet, err := ensureNetwork(ctx, dockerClient, nodeReq.NetworkID)
+		if err != nil {
+			return nil, fmt.Errorf("ensure network %q: %w", nodeReq.NetworkID, err)
+		}
+
+		subnets[nodeReq.NetworkID] = subnet
+	}
+
+	return subnets, nil
+}
+
+func ensureNetwork(ctx context.Context, dockerClient *client.Client, networkID string) (string, error) {
+	name := docker.NetworkName(networkID)
+
+	network, err := dockerClient.NetworkInspect(ctx, name, types.NetworkInspectOptions{})
+	if errdefs.IsNotFound(err) {
+		_, err = dockerClient.NetworkCreate(ctx, name, types.NetworkCreate{
+			Driver: "bridge",
+			Labels: map[string]string{privatebtc.NetworkIDLabel: networkID},
+		})
+		// the network might have been created in the meantime.
+		if err != nil && !errdefs.IsConflict(err) {
+			return "", fmt.Errorf("create docker network: %w", err)
+		}
+
+		network, err = dockerClient.NetworkInspect(ctx, name, types.NetworkInspectOptions{})
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("inspect docker network: %w", err)
+	}
+
+	if len(network.IPAM.Config) == 0 {
+		return "", fmt.Errorf("docker network %q: %w", name, errSubnetNotFound)
+	}
+
+	return network.IPAM.Config[0].Subnet, nil
+}
+
+var errSubnetNotFound = errors.New("subnet not found")
+
+// restoreSnapshot copies the data directory archive into the container
+// before bitcoind starts.
+func restoreSnapshot(snapshotPath string) testcontainers.ContainerHook {
+	return func(ctx context.Context, cont testcontainers.Container) error {
+		archive, err := os.Open(snapshotPath)
+		if err != nil {
+			return fmt.Errorf("open snapshot: %w", err)
+		}
+
+		defer archive.Close()
+
+		dockerClient, err := docker.NewClient()
+		if err != nil {
+			return fmt.Errorf("new docker client: %w", err)
+		}
+
+		defer dockerClient.Close()
+
+		if err := dockerClient.CopyToContainer(
+			ctx,
+			cont.GetContainerID(),
+			path.Dir(docker.BitcoinDataDir),
+			archive,
+			types.CopyToContainerOptions{},
+		); err != nil {
+			return fmt.Errorf("copy to container: %w", err)
+		}
+
+		return nil
+	}
+}
+
 func (s *NodeService) init() {
 	s.testcontLogger = log.New(io.Discard, "", 0)
 
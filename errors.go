@@ -26,6 +26,84 @@ var (
 	ErrTxFoundInMempool = errors.New("tx found in mempool")
 	// ErrNodeIndexOutOfRange is returned when a node index is out of range.
 	ErrNodeIndexOutOfRange = errors.New("node index out of range")
+	// ErrNodeNotFound is returned when a node with the given ID is not part of the network.
+	ErrNodeNotFound = errors.New("node not found")
+	// ErrPrunedNodeWithTxIndex is returned when a node is configured with both pruning
+	// and a transaction index, which bitcoind does not support.
+	ErrPrunedNodeWithTxIndex = errors.New("pruned node cannot maintain a transaction index")
+	// ErrSnapshotNodeCountMismatch is returned when a network is started from a snapshot
+	// taken from a network with a different number of nodes.
+	ErrSnapshotNodeCountMismatch = errors.New("snapshot node count mismatch")
+	// ErrNetworkNotFound is returned when attaching to a network without running nodes.
+	ErrNetworkNotFound = errors.New("network not found")
+	// ErrNetworkAlreadyStarted is returned when starting a network which already has nodes,
+	// e.g. an attached network.
+	ErrNetworkAlreadyStarted = errors.New("network already started")
+	// ErrNodeCannotPeerWithItself is returned when a topology connects a node to itself.
+	ErrNodeCannotPeerWithItself = errors.New("node cannot peer with itself")
+	// ErrPartitionNeedsAtLeastTwoGroups is returned when a network partition is requested
+	// with less than two groups.
+	ErrPartitionNeedsAtLeastTwoGroups = errors.New("partition needs at least two groups")
+	// ErrEmptyPartitionGroup is returned when a network partition group has no nodes.
+	ErrEmptyPartitionGroup = errors.New("empty partition group")
+	// ErrNodeInMultiplePartitionGroups is returned when a node is part of more than one
+	// network partition group.
+	ErrNodeInMultiplePartitionGroups = errors.New("node in multiple partition groups")
+	// ErrNodeNotInAnyPartitionGroup is returned when a node is not part of any
+	// network partition group.
+	ErrNodeNotInAnyPartitionGroup = errors.New("node not in any partition group")
+	// ErrPartitionGroupIndexOutOfRange is returned when a partition group index is out of range.
+	ErrPartitionGroupIndexOutOfRange = errors.New("partition group index out of range")
+	// ErrPartitionHealed is returned whenever a partition action is attempted after the
+	// partition was healed.
+	ErrPartitionHealed = errors.New("partition already healed")
+	// ErrInvalidNumBlocks is returned when mining less than one block.
+	ErrInvalidNumBlocks = errors.New("invalid number of blocks")
+	// ErrPartitionChainsTied is returned when a partition is healed and the longest chains
+	// of several groups have the same length.
+	ErrPartitionChainsTied = errors.New("partition chains tied")
+	// ErrInvalidAmount is returned when a BTC value cannot be converted to an Amount.
+	ErrInvalidAmount = errors.New("invalid amount")
+	// ErrNoPSBTSigners is returned when a PSBT is passed around an empty set of nodes for signing.
+	ErrNoPSBTSigners = errors.New("no psbt signers")
+	// ErrPSBTNotComplete is returned when a PSBT cannot be finalized because it is
+	// missing signatures.
+	ErrPSBTNotComplete = errors.New("psbt not complete")
+	// ErrImportDescriptorFailed is returned when a node fails to import a descriptor.
+	ErrImportDescriptorFailed = errors.New("import descriptor failed")
+	// ErrInvalidMultisigThreshold is returned when a multisig requires less than one
+	// signature or more signatures than it has signers.
+	ErrInvalidMultisigThreshold = errors.New("invalid multisig threshold")
+	// ErrNodeWithoutWallet is returned when a wallet operation is requested from a node
+	// which is not configured with a wallet.
+	ErrNodeWithoutWallet = errors.New("node without wallet")
+	// ErrSignerDescriptorNotFound is returned when the wallet of a multisig signer node
+	// has no active wpkh descriptor to take the signer key from.
+	ErrSignerDescriptorNotFound = errors.New("signer descriptor not found")
+	// ErrOutputNotFound is returned when a transaction has no output with the given index.
+	ErrOutputNotFound = errors.New("transaction output not found")
+	// ErrOutputTooSmallForCPFP is returned when the parent transaction output spent by
+	// a child pays for parent transaction does not cover the child fee.
+	ErrOutputTooSmallForCPFP = errors.New("output too small to pay for parent")
+	// ErrConflictingFeeOptions is returned when both a fee rate and a confirmation
+	// target are set in the send options.
+	ErrConflictingFeeOptions = errors.New("fee rate and conf target cannot be combined")
+	// ErrChildVSizeUnknown is returned when the wallet cannot estimate the virtual size
+	// of a child pays for parent transaction, e.g. when the spent output is not its own.
+	ErrChildVSizeUnknown = errors.New("child vsize unknown")
+	// ErrNoCoinControlInputs is returned when funding a coin controlled transaction
+	// without selecting any input.
+	ErrNoCoinControlInputs = errors.New("no coin control inputs")
+	// ErrInvalidTimelock is returned when a timelock is out of the range accepted
+	// by OP_CHECKLOCKTIMEVERIFY and OP_CHECKSEQUENCEVERIFY.
+	ErrInvalidTimelock = errors.New("invalid timelock")
+	// ErrTimelockKeyNotFound is returned when a wallet has no active wpkh descriptor
+	// to derive the key of a timelocked address from, or its new addresses are not
+	// derived from it.
+	ErrTimelockKeyNotFound = errors.New("timelock key not found")
+	// ErrDescriptorWithoutAddress is returned when deriving the address of a descriptor
+	// which has none.
+	ErrDescriptorWithoutAddress = errors.New("descriptor without address")
 )
 
 type peerCountShouldBeZeroError struct {
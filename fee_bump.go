@@ -0,0 +1,138 @@
+package privatebtc
+
+import (
+	"context"
+	"fmt"
+	"math"
+)
+
+// BumpedTransaction is a replacement of a wallet transaction paying a higher fee.
+type BumpedTransaction struct {
+	// TxID is the hash of the replacement transaction, set only by RPCClient.BumpFee.
+	TxID string
+	// PSBT is the base64 encoded unsigned replacement transaction, set only by
+	// RPCClient.PSBTBumpFee.
+	PSBT        string
+	OriginalFee Amount
+	Fee         Amount
+}
+
+// BumpFee performs a replace by fee(RBF) for the given transaction id.
+// Unlike ReplaceTransactionDrainToAddress, the replacement transaction keeps the
+// outputs and the recipient amounts of the replaced transaction, the fee is taken
+// from the change, and pays the given fee rate.
+func BumpFee(
+	ctx context.Context,
+	client RPCClient,
+	txID string,
+	targetFeeRate FeeRate,
+) (string, error) {
+	bumped, err := client.BumpFee(ctx, txID, targetFeeRate)
+	if err != nil {
+		return "", fmt.Errorf("bump fee: %w", err)
+	}
+
+	return bumped.TxID, nil
+}
+
+// ChildPaysForParent spends the given output of the given unconfirmed transaction
+// to the given address, paying a fee high enough for the package made of the
+// transaction, its unconfirmed ancestors and the child transaction to reach the
+// given fee rate. The child pays at least the given fee rate for itself.
+// The output has to belong to the wallet of the client. The child fee is computed
+// from the virtual size of the child, estimated by the wallet for the given address.
+func ChildPaysForParent(
+	ctx context.Context,
+	client RPCClient,
+	parentTxID string,
+	vout uint32,
+	address string,
+	targetFeeRate FeeRate,
+) (string, error) {
+	parent, err := client.GetMempoolEntry(ctx, parentTxID)
+	if err != nil {
+		return "", fmt.Errorf("get parent mempool entry: %w", err)
+	}
+
+	outputs, err := client.GetTransactionOutputs(ctx, parentTxID)
+	if err != nil {
+		return "", fmt.Errorf("get parent outputs: %w", err)
+	}
+
+	if int(vout) >= len(outputs) {
+		return "", fmt.Errorf("parent %q output %d: %w", parentTxID, vout, ErrOutputNotFound)
+	}
+
+	childVSize, err := estimateChildVSize(ctx, client, parentTxID, vout, address, outputs[vout].Value)
+	if err != nil {
+		return "", err
+	}
+
+	childFee := max(
+		packageFee(targetFeeRate, parent.AncestorSize+childVSize)-parent.AncestorFees,
+		packageFee(targetFeeRate, childVSize),
+	)
+
+	value := outputs[vout].Value
+	if childFee >= value {
+		return "", fmt.Errorf(
+			"output value %s, child fee %s: %w",
+			value,
+			childFee,
+			ErrOutputTooSmallForCPFP,
+		)
+	}
+
+	hash, err := client.SendCustomTransaction(
+		ctx,
+		[]TransactionVin{{TxID: parentTxID, Vout: vout}},
+		map[string]Amount{address: value - childFee},
+		0,
+	)
+	if err != nil {
+		return "", fmt.Errorf("send custom transaction: %w", err)
+	}
+
+	return hash, nil
+}
+
+// estimateChildVSize returns the virtual size of the child transaction spending the
+// given output to the given address, estimated from a draft of the child funded by
+// the wallet at the minimum relay fee rate. The draft is neither signed nor broadcast.
+func estimateChildVSize(
+	ctx context.Context,
+	client RPCClient,
+	parentTxID string,
+	vout uint32,
+	address string,
+	value Amount,
+) (int, error) {
+	const minRelayFeeRate = 1
+
+	draft, err := client.WalletCreateFundedPSBT(
+		ctx,
+		[]TransactionVin{{TxID: parentTxID, Vout: vout}},
+		map[string]Amount{address: value},
+		SendOptions{FeeRate: minRelayFeeRate, SubtractFeeFromAmount: true},
+	)
+	if err != nil {
+		return 0, fmt.Errorf("draft child: %w", err)
+	}
+
+	analysis, err := client.AnalyzePSBT(ctx, draft.PSBT)
+	if err != nil {
+		return 0, fmt.Errorf("analyze child: %w", err)
+	}
+
+	if analysis.EstimatedVSize == 0 {
+		return 0, fmt.Errorf("child of %q: %w", parentTxID, ErrChildVSizeUnknown)
+	}
+
+	return analysis.EstimatedVSize, nil
+}
+
+// packageFee returns the fee paid by a package of the given virtual size at the
+// given fee rate, rounded up to the next satoshi.
+func packageFee(feeRate FeeRate, vsize int) Amount {
+	return Amount(math.Ceil(float64(feeRate) * float64(vsize)))
+}
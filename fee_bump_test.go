@@ -0,0 +1,201 @@
+package privatebtc_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/adrianbrad/privatebtc"
+	"github.com/adrianbrad/privatebtc/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBumpFee(t *testing.T) {
+	t.Parallel()
+
+	errBumpFee := errors.New("bump fee error")
+
+	tests := map[string]struct {
+		bumpFeeErr    error
+		expectedHash  string
+		expectedError error
+	}{
+		"Success": {
+			expectedHash: "replacement",
+		},
+		"BumpFeeError": {
+			bumpFeeErr:    errBumpFee,
+			expectedError: errBumpFee,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := require.New(t)
+
+			client := &mock.RPCClient{
+				BumpFeeFunc: func(
+					_ context.Context,
+					txHash string,
+					feeRate privatebtc.FeeRate,
+				) (*privatebtc.BumpedTransaction, error) {
+					if test.bumpFeeErr != nil {
+						return nil, test.bumpFeeErr
+					}
+
+					req.Equal("original", txHash)
+					req.Equal(privatebtc.FeeRate(20), feeRate)
+
+					return &privatebtc.BumpedTransaction{TxID: "replacement", OriginalFee: 1410, Fee: 2820}, nil
+				},
+			}
+
+			txHash, err := privatebtc.BumpFee(context.Background(), client, "original", 20)
+			req.ErrorIs(err, test.expectedError)
+			req.Equal(test.expectedHash, txHash)
+		})
+	}
+}
+
+func TestChildPaysForParent(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		ancestorFees   privatebtc.Amount
+		vout           uint32
+		outputValue    privatebtc.Amount
+		childVSize     int
+		expectedAmount privatebtc.Amount
+		expectedError  error
+	}{
+		"PaysForParent": {
+			// the package of 141 + 110 vB pays 10 sat/vB.
+			ancestorFees:   141,
+			vout:           1,
+			outputValue:    100_000,
+			childVSize:     110,
+			expectedAmount: 100_000 - (2510 - 141),
+		},
+		"P2WSHChild": {
+			// the package of 141 + 122 vB pays 10 sat/vB.
+			ancestorFees:   141,
+			vout:           1,
+			outputValue:    100_000,
+			childVSize:     122,
+			expectedAmount: 100_000 - (2630 - 141),
+		},
+		"PaysAtLeastForItself": {
+			// the parent already pays 100 sat/vB, the child pays 10 sat/vB for its 110 vB.
+			ancestorFees:   14_100,
+			vout:           1,
+			outputValue:    100_000,
+			childVSize:     110,
+			expectedAmount: 100_000 - 1100,
+		},
+		"OutputTooSmall": {
+			ancestorFees:  141,
+			vout:          1,
+			outputValue:   2000,
+			childVSize:    110,
+			expectedError: privatebtc.ErrOutputTooSmallForCPFP,
+		},
+		"OutputNotFound": {
+			ancestorFees:  141,
+			vout:          2,
+			outputValue:   100_000,
+			expectedError: privatebtc.ErrOutputNotFound,
+		},
+		"ChildVSizeUnknown": {
+			ancestorFees:  141,
+			vout:          1,
+			outputValue:   100_000,
+			expectedError: privatebtc.ErrChildVSizeUnknown,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := require.New(t)
+
+			var sent map[string]privatebtc.Amount
+
+			client := &mock.RPCClient{
+				GetMempoolEntryFunc: func(context.Context, string) (*privatebtc.MempoolEntry, error) {
+					return &privatebtc.MempoolEntry{
+						VSize:         141,
+						Fee:           test.ancestorFees,
+						AncestorCount: 1,
+						AncestorSize:  141,
+						AncestorFees:  test.ancestorFees,
+					}, nil
+				},
+				GetTransactionOutputsFunc: func(
+					context.Context,
+					string,
+				) ([]privatebtc.MempoolTransactionOutput, error) {
+					return []privatebtc.MempoolTransactionOutput{
+						{Address: "receiver", Value: privatebtc.BTC},
+						{Address: "change", Value: test.outputValue},
+					}, nil
+				},
+				WalletCreateFundedPSBTFunc: func(
+					_ context.Context,
+					inputs []privatebtc.TransactionVin,
+					amounts map[string]privatebtc.Amount,
+					opts privatebtc.SendOptions,
+				) (*privatebtc.FundedPSBT, error) {
+					req.Equal([]privatebtc.TransactionVin{{TxID: "parent", Vout: test.vout}}, inputs)
+					req.Equal(map[string]privatebtc.Amount{"addr": test.outputValue}, amounts)
+					req.True(opts.SubtractFeeFromAmount)
+
+					return &privatebtc.FundedPSBT{PSBT: "draft"}, nil
+				},
+				AnalyzePSBTFunc: func(_ context.Context, psbt string) (*privatebtc.PSBTAnalysis, error) {
+					req.Equal("draft", psbt)
+
+					return &privatebtc.PSBTAnalysis{EstimatedVSize: test.childVSize}, nil
+				},
+				SendCustomTransactionFunc: func(
+					_ context.Context,
+					inputs []privatebtc.TransactionVin,
+					amounts map[string]privatebtc.Amount,
+					lockTime uint32,
+				) (string, error) {
+					req.Equal([]privatebtc.TransactionVin{{TxID: "parent", Vout: test.vout}}, inputs)
+					req.Zero(lockTime)
+
+					sent = amounts
+
+					return "child", nil
+				},
+			}
+
+			txHash, err := privatebtc.ChildPaysForParent(
+				context.Background(),
+				client,
+				"parent",
+				test.vout,
+				"addr",
+				10,
+			)
+			req.ErrorIs(err, test.expectedError)
+
+			if test.expectedError != nil {
+				req.Nil(sent)
+
+				return
+			}
+
+			req.Equal("child", txHash)
+			req.Equal(map[string]privatebtc.Amount{"addr": test.expectedAmount}, sent)
+		})
+	}
+}
@@ -0,0 +1,211 @@
+package privatebtc_test
+
+import (
+	"context"
+	"strconv"
+	"sync"
+
+	"github.com/adrianbrad/privatebtc"
+	"github.com/adrianbrad/privatebtc/mock"
+	"golang.org/x/exp/slices"
+)
+
+// mockNodes creates the mock nodes of a private network and keeps track of the
+// peer connections between them, so that the network can be started.
+// Every node is identified by its host RPC port, which is the string
+// representation of the node index.
+type mockNodes struct {
+	// newRPCClient returns the mock RPC client of the node with the given index,
+	// an empty client is used when it is nil.
+	newRPCClient func(id int) *mock.RPCClient
+
+	mu      sync.Mutex
+	peers   map[int]map[int]struct{}
+	added   [][2]int
+	removed [][2]int
+	closed  []int
+}
+
+func newMockNodes(newRPCClient func(id int) *mock.RPCClient) *mockNodes {
+	return &mockNodes{
+		newRPCClient: newRPCClient,
+		peers:        map[int]map[int]struct{}{},
+	}
+}
+
+func (m *mockNodes) nodeService(nodes int) *mock.NodeService {
+	handlers := make([]privatebtc.NodeHandler, nodes)
+
+	for i := range handlers {
+		handlers[i] = newPrivateNetworkStartSuccessContainerWithPort(strconv.Itoa(i))
+	}
+
+	return newPrivateNetworkStartSuccessDockerService(handlers...)
+}
+
+// namedNodeService creates a mock node for every request, the node index is taken
+// from the request node ID so nodes added to a running network get their own port.
+func (m *mockNodes) namedNodeService() *mock.NodeService {
+	return &mock.NodeService{
+		CreateNodesFunc: func(
+			_ context.Context,
+			nodeRequests []privatebtc.CreateNodeRequest,
+		) ([]privatebtc.NodeHandler, error) {
+			handlers := make([]privatebtc.NodeHandler, len(nodeRequests))
+
+			for i, nodeReq := range nodeRequests {
+				id := nodeReq.NodeID
+				port := strconv.Itoa(id)
+
+				handlers[i] = &mock.NodeHandler{
+					HostRPCPortFunc: func() string {
+						return port
+					},
+					CloseFunc: func() error {
+						m.mu.Lock()
+						defer m.mu.Unlock()
+
+						m.closed = append(m.closed, id)
+
+						return nil
+					},
+				}
+			}
+
+			return handlers, nil
+		},
+		RemoveNetworkFunc: func(context.Context, string) error {
+			return nil
+		},
+	}
+}
+
+func (m *mockNodes) closedNodes() []int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return slices.Clone(m.closed)
+}
+
+func (m *mockNodes) rpcClientFactory() *mock.RPCClientFactory {
+	return &mock.RPCClientFactory{
+		NewRPCClientFunc: func(
+			_ context.Context,
+			hostRPCPort string,
+			rpcUser string,
+			rpcPass string,
+		) (privatebtc.RPCClient, error) {
+			id, err := strconv.Atoi(hostRPCPort)
+			if err != nil {
+				return nil, err
+			}
+
+			return m.rpcClient(id), nil
+		},
+	}
+}
+
+// rpcClient returns the mock RPC client of the node with the given index.
+// The wallet creation succeeds unless the client defines it, and the peer
+// connections of the client are recorded, after the peer calls it defines succeed.
+func (m *mockNodes) rpcClient(id int) *mock.RPCClient {
+	c := &mock.RPCClient{}
+
+	if m.newRPCClient != nil {
+		c = m.newRPCClient(id)
+	}
+
+	if c.CreateWalletFunc == nil {
+		c.CreateWalletFunc = func(context.Context, string) error {
+			return nil
+		}
+	}
+
+	if c.GetConnectionCountFunc == nil {
+		c.GetConnectionCountFunc = func(context.Context) (int, error) {
+			m.mu.Lock()
+			defer m.mu.Unlock()
+
+			return len(m.peers[id]), nil
+		}
+	}
+
+	addPeer, removePeer := c.AddPeerFunc, c.RemovePeerFunc
+
+	c.AddPeerFunc = func(ctx context.Context, peer privatebtc.Node) error {
+		if addPeer != nil {
+			if err := addPeer(ctx, peer); err != nil {
+				return err
+			}
+		}
+
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		m.added = append(m.added, [2]int{id, peer.ID()})
+		m.connect(id, peer.ID())
+
+		return nil
+	}
+
+	c.RemovePeerFunc = func(ctx context.Context, peer privatebtc.Node) error {
+		if removePeer != nil {
+			if err := removePeer(ctx, peer); err != nil {
+				return err
+			}
+		}
+
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		m.removed = append(m.removed, [2]int{id, peer.ID()})
+
+		delete(m.peers[id], peer.ID())
+		delete(m.peers[peer.ID()], id)
+
+		return nil
+	}
+
+	return c
+}
+
+func (m *mockNodes) connect(a, b int) {
+	if m.peers[a] == nil {
+		m.peers[a] = map[int]struct{}{}
+	}
+
+	if m.peers[b] == nil {
+		m.peers[b] = map[int]struct{}{}
+	}
+
+	m.peers[a][b] = struct{}{}
+	m.peers[b][a] = struct{}{}
+}
+
+func (m *mockNodes) sortedAdded() [][2]int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return sortedPairs(m.added)
+}
+
+func (m *mockNodes) sortedRemoved() [][2]int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return sortedPairs(m.removed)
+}
+
+func sortedPairs(pairs [][2]int) [][2]int {
+	sorted := slices.Clone(pairs)
+
+	slices.SortFunc(sorted, func(a, b [2]int) int {
+		if a[0] != b[0] {
+			return a[0] - b[0]
+		}
+
+		return a[1] - b[1]
+	})
+
+	return sorted
+}
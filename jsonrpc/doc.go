@@ -0,0 +1,8 @@
+// Package jsonrpc provides implementations of the privatebtc.RPCClientFactory
+// and privatebtc.RPCClient interfaces speaking the Bitcoin Core JSON-RPC API
+// directly over net/http.
+// Every RPC call is bound to its context, the HTTP request is aborted once the
+// context is done.
+// Independent calls can be sent in a single round trip using JSON-RPC batches,
+// see RPCClient.Batch.
+package jsonrpc
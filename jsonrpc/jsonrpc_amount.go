@@ -0,0 +1,90 @@
+package jsonrpc
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/adrianbrad/privatebtc"
+)
+
+const btcDecimals = 8
+
+// amount is a privatebtc.Amount which is encoded as a BTC decimal, as represented
+// by the bitcoin RPC API.
+// The decimals are converted to and from satoshis without float arithmetic.
+type amount privatebtc.Amount
+
+// MarshalJSON encodes the amount as a BTC decimal with 8 decimals.
+func (a amount) MarshalJSON() ([]byte, error) {
+	sats := int64(a)
+
+	sign := ""
+	if sats < 0 {
+		sign, sats = "-", -sats
+	}
+
+	return []byte(fmt.Sprintf(
+		"%s%d.%08d",
+		sign,
+		sats/int64(privatebtc.BTC),
+		sats%int64(privatebtc.BTC),
+	)), nil
+}
+
+// UnmarshalJSON decodes a BTC decimal, e.g. 0.00100000.
+func (a *amount) UnmarshalJSON(b []byte) error {
+	var n json.Number
+	if err := json.Unmarshal(b, &n); err != nil {
+		return fmt.Errorf("unmarshal amount: %w", err)
+	}
+
+	sats, ok := parseSatoshis(n.String())
+	if ok {
+		*a = amount(sats)
+
+		return nil
+	}
+
+	// fall back to float parsing for the decimals which are not in the plain
+	// notation, e.g. exponents.
+	btc, err := n.Float64()
+	if err != nil {
+		return fmt.Errorf("parse amount %q: %w", n, err)
+	}
+
+	v, err := privatebtc.AmountFromBTC(btc)
+	if err != nil {
+		return fmt.Errorf("parse amount %q: %w", n, err)
+	}
+
+	*a = amount(v)
+
+	return nil
+}
+
+// parseSatoshis parses a BTC decimal with at most 8 decimals in the plain
+// notation, e.g. -1.5 or 0.00100000, to satoshis.
+func parseSatoshis(s string) (int64, bool) {
+	neg := strings.HasPrefix(s, "-")
+	s = strings.TrimPrefix(s, "-")
+
+	whole, frac, _ := strings.Cut(s, ".")
+	if whole == "" || len(frac) > btcDecimals {
+		return 0, false
+	}
+
+	frac += strings.Repeat("0", btcDecimals-len(frac))
+
+	sats, err := strconv.ParseInt(whole+frac, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	if neg {
+		sats = -sats
+	}
+
+	return sats, true
+}
@@ -0,0 +1,191 @@
+package jsonrpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// bitcoind serves the legacy JSON-RPC 1.0 flavour, in which errors are reported
+// in the response body along with a non 2xx status code.
+const jsonRPCVersion = "1.0"
+
+var (
+	// ErrUnexpectedStatusCode is returned when the node responds with a status
+	// code and a body which is not a JSON-RPC response, e.g. on failed authentication.
+	ErrUnexpectedStatusCode = errors.New("unexpected status code")
+	// ErrMissingResponse is returned when a batch response has no response for a call.
+	ErrMissingResponse = errors.New("missing response")
+)
+
+// Error is an error returned by the node for an RPC call.
+type Error struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("rpc error %d: %s", e.Code, e.Message)
+}
+
+// Call is a single RPC call of a batch.
+type Call struct {
+	Method string
+	Params []any
+	// Result is the value the call result is decoded into, the result is
+	// discarded when it is nil.
+	Result any
+	// Err is the error of the call, set once the batch is sent.
+	Err error
+}
+
+// NewCall returns a call of the given method, the call result is decoded into result.
+func NewCall(result any, method string, params ...any) *Call {
+	return &Call{Method: method, Params: params, Result: result}
+}
+
+type request struct {
+	JSONRPC string `json:"jsonrpc"`
+	ID      uint64 `json:"id"`
+	Method  string `json:"method"`
+	Params  []any  `json:"params"`
+}
+
+type response struct {
+	ID     uint64          `json:"id"`
+	Result json.RawMessage `json:"result"`
+	Error  *Error          `json:"error"`
+}
+
+// call sends a single RPC call and decodes its result into result.
+func (c RPCClient) call(ctx context.Context, result any, method string, params ...any) error {
+	if params == nil {
+		params = []any{}
+	}
+
+	req := request{
+		JSONRPC: jsonRPCVersion,
+		ID:      c.nextID.Add(1),
+		Method:  method,
+		Params:  params,
+	}
+
+	var resp response
+
+	if err := c.do(ctx, req, &resp); err != nil {
+		return err
+	}
+
+	return resp.decode(result)
+}
+
+// Batch sends the given calls in a single JSON-RPC batch request.
+// The returned error is set only when the batch as a whole fails, the error of
+// every call is set on the call itself.
+func (c RPCClient) Batch(ctx context.Context, calls ...*Call) error {
+	if len(calls) == 0 {
+		return nil
+	}
+
+	reqs := make([]request, len(calls))
+	callsByID := make(map[uint64]*Call, len(calls))
+
+	for i, call := range calls {
+		params := call.Params
+		if params == nil {
+			params = []any{}
+		}
+
+		reqs[i] = request{
+			JSONRPC: jsonRPCVersion,
+			ID:      c.nextID.Add(1),
+			Method:  call.Method,
+			Params:  params,
+		}
+
+		callsByID[reqs[i].ID] = call
+	}
+
+	var resps []response
+
+	if err := c.do(ctx, reqs, &resps); err != nil {
+		return err
+	}
+
+	for i := range resps {
+		call, ok := callsByID[resps[i].ID]
+		if !ok {
+			continue
+		}
+
+		delete(callsByID, resps[i].ID)
+
+		call.Err = resps[i].decode(call.Result)
+	}
+
+	for _, call := range callsByID {
+		call.Err = ErrMissingResponse
+	}
+
+	return nil
+}
+
+// do posts the given JSON-RPC request body and decodes the response body into resp.
+func (c RPCClient) do(ctx context.Context, body, resp any) error {
+	reqBody, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("marshal request: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(reqBody))
+	if err != nil {
+		return fmt.Errorf("new request: %w", err)
+	}
+
+	httpReq.SetBasicAuth(c.user, c.pass)
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	httpResp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("do request: %w", err)
+	}
+
+	defer httpResp.Body.Close()
+
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return fmt.Errorf("read response: %w", err)
+	}
+
+	// the node reports the RPC errors with a non 2xx status code and a JSON-RPC
+	// response body, only the responses which cannot be decoded are failures.
+	if err := json.Unmarshal(respBody, resp); err != nil {
+		if httpResp.StatusCode != http.StatusOK {
+			return fmt.Errorf("%w: %d", ErrUnexpectedStatusCode, httpResp.StatusCode)
+		}
+
+		return fmt.Errorf("unmarshal response: %w", err)
+	}
+
+	return nil
+}
+
+func (r response) decode(result any) error {
+	if r.Error != nil {
+		return r.Error
+	}
+
+	if result == nil {
+		return nil
+	}
+
+	if err := json.Unmarshal(r.Result, result); err != nil {
+		return fmt.Errorf("unmarshal result: %w", err)
+	}
+
+	return nil
+}
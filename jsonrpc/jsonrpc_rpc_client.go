@@ -0,0 +1,1520 @@
+package jsonrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/adrianbrad/privatebtc"
+)
+
+var (
+	_ privatebtc.RPCClient                     = (*RPCClient)(nil)
+	_ privatebtc.BatchTransactionOutputsGetter = (*RPCClient)(nil)
+	_ privatebtc.BatchMempoolEntriesGetter     = (*RPCClient)(nil)
+)
+
+// RPCClient is an RPC client for a BTC node.
+type RPCClient struct {
+	url string
+	// baseURL is the url of the node, without the wallet path.
+	baseURL    string
+	user       string
+	pass       string
+	httpClient *http.Client
+	nextID     *atomic.Uint64
+}
+
+// WalletWarningError is an error returned by the RPC client when a wallet warning is encountered.
+type WalletWarningError string
+
+func (e WalletWarningError) Error() string {
+	return fmt.Sprintf("wallet warning: %s", string(e))
+}
+
+// walletResult is the result of the createwallet and loadwallet RPCs.
+// bitcoind v25 deprecated the warning field in favour of the warnings field.
+type walletResult struct {
+	Warning  string   `json:"warning"`
+	Warnings []string `json:"warnings"`
+}
+
+func (r walletResult) err() error {
+	if len(r.Warnings) != 0 {
+		return WalletWarningError(strings.Join(r.Warnings, "; "))
+	}
+
+	if r.Warning != "" {
+		return WalletWarningError(r.Warning)
+	}
+
+	return nil
+}
+
+// mempoolEntry is the result of the getmempoolentry RPC.
+// The fee fields outside of fees were removed in bitcoind v23.
+// nolint: tagliatelle
+type mempoolEntry struct {
+	VSize           int   `json:"vsize"`
+	Time            int64 `json:"time"`
+	AncestorCount   int   `json:"ancestorcount"`
+	AncestorSize    int   `json:"ancestorsize"`
+	DescendantCount int   `json:"descendantcount"`
+	DescendantSize  int   `json:"descendantsize"`
+	Fees            struct {
+		Base       amount `json:"base"`
+		Ancestor   amount `json:"ancestor"`
+		Descendant amount `json:"descendant"`
+	} `json:"fees"`
+	BIP125Replaceable bool `json:"bip125-replaceable"`
+}
+
+func (e mempoolEntry) toMempoolEntry() *privatebtc.MempoolEntry {
+	return &privatebtc.MempoolEntry{
+		VSize:             e.VSize,
+		Fee:               privatebtc.Amount(e.Fees.Base),
+		Time:              time.Unix(e.Time, 0),
+		AncestorCount:     e.AncestorCount,
+		AncestorSize:      e.AncestorSize,
+		AncestorFees:      privatebtc.Amount(e.Fees.Ancestor),
+		DescendantCount:   e.DescendantCount,
+		DescendantSize:    e.DescendantSize,
+		DescendantFees:    privatebtc.Amount(e.Fees.Descendant),
+		BIP125Replaceable: e.BIP125Replaceable,
+	}
+}
+
+// rawTransaction is the verbose transaction of the getrawtransaction and getblock RPCs.
+// nolint: tagliatelle
+type rawTransaction struct {
+	TxID      string `json:"txid"`
+	Hash      string `json:"hash"`
+	BlockHash string `json:"blockhash"`
+	Vin       []struct {
+		TxID string `json:"txid"`
+		Vout uint32 `json:"vout"`
+	} `json:"vin"`
+	Vouts []struct {
+		Value        amount `json:"value"`
+		N            uint32 `json:"n"`
+		ScriptPubKey struct {
+			Address string `json:"address"`
+		} `json:"scriptPubKey"`
+	} `json:"vout"`
+}
+
+func (tx rawTransaction) toTransaction() *privatebtc.Transaction {
+	vouts := make([]privatebtc.TransactionVout, len(tx.Vouts))
+
+	for i, v := range tx.Vouts {
+		vouts[i] = privatebtc.TransactionVout{
+			Value:        privatebtc.Amount(v.Value),
+			ScriptPubKey: struct{ Address string }{Address: v.ScriptPubKey.Address},
+			N:            v.N,
+		}
+	}
+
+	vins := make([]privatebtc.TransactionVin, len(tx.Vin))
+
+	for i, v := range tx.Vin {
+		vins[i] = privatebtc.TransactionVin{
+			TxID: v.TxID,
+			Vout: v.Vout,
+		}
+	}
+
+	return &privatebtc.Transaction{
+		TxID:      tx.TxID,
+		Hash:      tx.Hash,
+		BlockHash: tx.BlockHash,
+		Vout:      vouts,
+		Vin:       vins,
+	}
+}
+
+func (tx rawTransaction) outputs() []privatebtc.MempoolTransactionOutput {
+	outputs := make([]privatebtc.MempoolTransactionOutput, len(tx.Vouts))
+
+	for i, v := range tx.Vouts {
+		outputs[i] = privatebtc.MempoolTransactionOutput{
+			Address: v.ScriptPubKey.Address,
+			Value:   privatebtc.Amount(v.Value),
+		}
+	}
+
+	return outputs
+}
+
+// txInput is a transaction input of the createrawtransaction and walletcreatefundedpsbt RPCs.
+type txInput struct {
+	TxID     string `json:"txid"`
+	Vout     uint32 `json:"vout"`
+	Sequence uint32 `json:"sequence,omitempty"`
+}
+
+// txInputs converts the given inputs, the result is never nil as the node rejects
+// a null inputs param.
+func txInputs(inputs []privatebtc.TransactionVin) []txInput {
+	jsonInputs := make([]txInput, len(inputs))
+
+	for i := range inputs {
+		jsonInputs[i] = txInput{
+			TxID:     inputs[i].TxID,
+			Vout:     inputs[i].Vout,
+			Sequence: inputs[i].Sequence,
+		}
+	}
+
+	return jsonInputs
+}
+
+// GetNewAddress generates a new BTC address.
+func (c RPCClient) GetNewAddress(ctx context.Context, label string) (string, error) {
+	var addr string
+
+	if err := c.call(ctx, &addr, "getnewaddress", label); err != nil {
+		return "", err
+	}
+
+	return addr, nil
+}
+
+// GetConnectionCount returns the number of connections to other nodes.
+func (c RPCClient) GetConnectionCount(ctx context.Context) (int, error) {
+	var count int
+
+	if err := c.call(ctx, &count, "getconnectioncount"); err != nil {
+		return 0, fmt.Errorf("get connection count: %w", err)
+	}
+
+	return count, nil
+}
+
+// GetRawMempool returns the hashes of all transactions in the mempool.
+func (c RPCClient) GetRawMempool(ctx context.Context) ([]string, error) {
+	var hashes []string
+
+	if err := c.call(ctx, &hashes, "getrawmempool"); err != nil {
+		return nil, err
+	}
+
+	return hashes, nil
+}
+
+// GetMempoolEntry returns the mempool entry of the transaction with the given hash.
+func (c RPCClient) GetMempoolEntry(ctx context.Context, txHash string) (*privatebtc.MempoolEntry, error) {
+	var res mempoolEntry
+
+	if err := c.call(ctx, &res, "getmempoolentry", txHash); err != nil {
+		if isTxNotInMempoolError(err) {
+			return nil, fmt.Errorf("tx %q: %w", txHash, privatebtc.ErrTxNotFoundInMempool)
+		}
+
+		return nil, fmt.Errorf("get mempool entry: %w", err)
+	}
+
+	return res.toMempoolEntry(), nil
+}
+
+// isTxNotInMempoolError reports whether the given error is the getmempoolentry or
+// getrawtransaction error for a transaction which is not in the mempool.
+func isTxNotInMempoolError(err error) bool {
+	var rpcErr *Error
+
+	return errors.As(err, &rpcErr) &&
+		rpcErr.Code == errCodeInvalidAddressOrKey &&
+		(rpcErr.Message == "Transaction not in mempool" ||
+			// the getrawtransaction message depends on the node version and on -txindex.
+			strings.HasPrefix(rpcErr.Message, "No such mempool"))
+}
+
+// errCodeInvalidAddressOrKey is the RPC_INVALID_ADDRESS_OR_KEY error code.
+const errCodeInvalidAddressOrKey = -5
+
+// GetBlockCount returns the current block count.
+func (c RPCClient) GetBlockCount(ctx context.Context) (int, error) {
+	var count int
+
+	if err := c.call(ctx, &count, "getblockcount"); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// CreateWallet creates a wallet with the given name.
+func (c RPCClient) CreateWallet(ctx context.Context, walletName string) error {
+	var res walletResult
+
+	if err := c.call(ctx, &res, "createwallet", walletName); err != nil {
+		return fmt.Errorf("create wallet: %w", err)
+	}
+
+	return res.err()
+}
+
+// LoadWallet loads the existing wallet with the given name.
+func (c RPCClient) LoadWallet(ctx context.Context, walletName string) error {
+	var res walletResult
+
+	if err := c.call(ctx, &res, "loadwallet", walletName); err != nil {
+		return fmt.Errorf("load wallet: %w", err)
+	}
+
+	return res.err()
+}
+
+// UnloadWallet unloads the wallet with the given name.
+func (c RPCClient) UnloadWallet(ctx context.Context, walletName string) error {
+	var res walletResult
+
+	if err := c.call(ctx, &res, "unloadwallet", walletName); err != nil {
+		return fmt.Errorf("unload wallet: %w", err)
+	}
+
+	return res.err()
+}
+
+// ListWallets returns the names of the loaded wallets.
+func (c RPCClient) ListWallets(ctx context.Context) ([]string, error) {
+	var wallets []string
+
+	if err := c.call(ctx, &wallets, "listwallets"); err != nil {
+		return nil, fmt.Errorf("list wallets: %w", err)
+	}
+
+	return wallets, nil
+}
+
+// CreateWatchOnlyWallet creates a blank descriptor wallet, with private keys
+// disabled, with the given name.
+func (c RPCClient) CreateWatchOnlyWallet(ctx context.Context, walletName string) error {
+	const (
+		disablePrivateKeys = true
+		blank              = true
+		passphrase         = ""
+		avoidReuse         = false
+		descriptors        = true
+	)
+
+	var res walletResult
+
+	if err := c.call(
+		ctx,
+		&res,
+		"createwallet",
+		walletName,
+		disablePrivateKeys,
+		blank,
+		passphrase,
+		avoidReuse,
+		descriptors,
+	); err != nil {
+		return fmt.Errorf("create wallet: %w", err)
+	}
+
+	return res.err()
+}
+
+// Wallet returns a client whose wallet RPCs are served by the wallet with the given name.
+func (c RPCClient) Wallet(walletName string) (privatebtc.RPCClient, error) {
+	c.url = c.baseURL + "/wallet/" + url.PathEscape(walletName)
+
+	return c, nil
+}
+
+// FlushChainState flushes the node chain state to disk.
+// The gettxoutsetinfo RPC flushes the chain state before computing the UTXO set
+// statistics, the coinstats index is not used so that the flush always happens.
+// Requires Bitcoin Core v22 or newer.
+func (c RPCClient) FlushChainState(ctx context.Context) error {
+	if err := c.call(ctx, nil, "gettxoutsetinfo", "none", nil, false); err != nil {
+		return fmt.Errorf("get tx out set info: %w", err)
+	}
+
+	return nil
+}
+
+// SetMockTime sets the node local time to the given time, the zero time resets
+// the node to the system time.
+func (c RPCClient) SetMockTime(ctx context.Context, t time.Time) error {
+	// 0 disables the mock time.
+	var timestamp int64
+
+	if !t.IsZero() {
+		timestamp = t.Unix()
+	}
+
+	if err := c.call(ctx, nil, "setmocktime", timestamp); err != nil {
+		return fmt.Errorf("set mock time: %w", err)
+	}
+
+	return nil
+}
+
+// SendToAddress sends the given amount to the given address.
+// The transaction is sent using the send RPC when any option is set, as the
+// sendtoaddress RPC does not support setting the change address.
+func (c RPCClient) SendToAddress(
+	ctx context.Context,
+	address string,
+	amnt privatebtc.Amount,
+	opts privatebtc.SendOptions,
+) (string, error) {
+	if err := opts.Validate(); err != nil {
+		return "", err
+	}
+
+	if !opts.IsZero() {
+		return c.send(ctx, address, amnt, opts)
+	}
+
+	var txHash string
+
+	if err := c.call(ctx, &txHash, "sendtoaddress", address, amount(amnt)); err != nil {
+		return "", fmt.Errorf("send to address: %w", err)
+	}
+
+	return txHash, nil
+}
+
+// send sends the given amount to the given address using the send RPC.
+// Requires Bitcoin Core v21 or newer.
+func (c RPCClient) send(
+	ctx context.Context,
+	address string,
+	amnt privatebtc.Amount,
+	opts privatebtc.SendOptions,
+) (string, error) {
+	var res struct {
+		Complete bool   `json:"complete"`
+		TxID     string `json:"txid"`
+	}
+
+	if err := c.call(
+		ctx,
+		&res,
+		"send",
+		[]map[string]amount{{address: amount(amnt)}},
+		nil,
+		"unset",
+		nil,
+		newSendOptions(opts),
+	); err != nil {
+		return "", fmt.Errorf("send: %w", err)
+	}
+
+	if !res.Complete {
+		return "", privatebtc.ErrPSBTNotComplete
+	}
+
+	return res.TxID, nil
+}
+
+// sendOptions is the options argument of the send RPC.
+// nolint: tagliatelle
+type sendOptions struct {
+	FeeRate                float64 `json:"fee_rate,omitempty"`
+	ConfTarget             int     `json:"conf_target,omitempty"`
+	SubtractFeeFromOutputs []int   `json:"subtract_fee_from_outputs,omitempty"`
+	Replaceable            bool    `json:"replaceable,omitempty"`
+	ChangeAddress          string  `json:"change_address,omitempty"`
+	LockTime               uint32  `json:"locktime,omitempty"`
+}
+
+func newSendOptions(opts privatebtc.SendOptions) sendOptions {
+	options := sendOptions{
+		FeeRate:       float64(opts.FeeRate),
+		ConfTarget:    opts.ConfTarget,
+		Replaceable:   opts.Replaceable,
+		ChangeAddress: opts.ChangeAddress,
+		LockTime:      opts.LockTime,
+	}
+
+	// the receiver is the single output.
+	options.SubtractFeeFromOutputs = opts.SubtractFeeFromOutputs(1)
+
+	return options
+}
+
+// SendCustomTransaction sends a custom transaction with the given inputs and amounts.
+func (c RPCClient) SendCustomTransaction(
+	ctx context.Context,
+	inputs []privatebtc.TransactionVin,
+	amounts map[string]privatebtc.Amount,
+	lockTime uint32,
+) (string, error) {
+	outputs := make(map[string]amount, len(amounts))
+
+	for addr, amnt := range amounts {
+		outputs[addr] = amount(amnt)
+	}
+
+	var rawTx string
+
+	if err := c.call(ctx, &rawTx, "createrawtransaction", txInputs(inputs), outputs, lockTime); err != nil {
+		return "", fmt.Errorf("create raw transaction: %w", err)
+	}
+
+	var signed struct {
+		Hex string `json:"hex"`
+	}
+
+	if err := c.call(ctx, &signed, "signrawtransactionwithwallet", rawTx); err != nil {
+		return "", fmt.Errorf("sign raw transaction: %w", err)
+	}
+
+	return c.SendRawTransaction(ctx, signed.Hex)
+}
+
+// GenerateToAddress generates numBlocks blocks and sends the coinbase to the given address.
+func (c RPCClient) GenerateToAddress(
+	ctx context.Context,
+	numBlocks int64,
+	address string,
+) ([]string, error) {
+	var hashes []string
+
+	if err := c.call(ctx, &hashes, "generatetoaddress", numBlocks, address); err != nil {
+		return nil, fmt.Errorf("generate to address: %w", err)
+	}
+
+	return hashes, nil
+}
+
+// AddPeer adds a peer to the node.
+func (c RPCClient) AddPeer(ctx context.Context, peer privatebtc.Node) error {
+	addr := fmt.Sprintf(
+		"%s:%s",
+		peer.NodeHandler().InternalIP(),
+		privatebtc.P2PRegtestDefaultPort,
+	)
+
+	return c.call(ctx, nil, "addnode", addr, "onetry")
+}
+
+// RemovePeer removes a peer from the node.
+func (c RPCClient) RemovePeer(ctx context.Context, peer privatebtc.Node) error {
+	peers, err := c.GetPeerInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	var addr string
+
+	for i := range peers {
+		if peers[i].IP() == peer.NodeHandler().InternalIP() {
+			addr = peers[i].Addr
+		}
+	}
+
+	if addr == "" {
+		return privatebtc.ErrPeerNotFound
+	}
+
+	if err := c.call(ctx, nil, "disconnectnode", addr); err != nil {
+		return fmt.Errorf("disconnect node: %w", err)
+	}
+
+	return nil
+}
+
+// GetPeerInfo returns the peers connected to the node.
+func (c RPCClient) GetPeerInfo(ctx context.Context) ([]privatebtc.Peer, error) {
+	// nolint: tagliatelle
+	var res []struct {
+		ID             int      `json:"id"`
+		Addr           string   `json:"addr"`
+		Inbound        bool     `json:"inbound"`
+		ConnectionType string   `json:"connection_type"`
+		StartingHeight int      `json:"startingheight"`
+		SyncedHeaders  int      `json:"synced_headers"`
+		SyncedBlocks   int      `json:"synced_blocks"`
+		PingTime       float64  `json:"pingtime"`
+		BytesSent      uint64   `json:"bytessent"`
+		BytesRecv      uint64   `json:"bytesrecv"`
+		Services       string   `json:"services"`
+		ServicesNames  []string `json:"servicesnames"`
+		Version        int      `json:"version"`
+		SubVer         string   `json:"subver"`
+	}
+
+	if err := c.call(ctx, &res, "getpeerinfo"); err != nil {
+		return nil, fmt.Errorf("get peer info: %w", err)
+	}
+
+	peers := make([]privatebtc.Peer, len(res))
+
+	for i, p := range res {
+		peers[i] = privatebtc.Peer{
+			ID:             p.ID,
+			Addr:           p.Addr,
+			Inbound:        p.Inbound,
+			ConnectionType: p.ConnectionType,
+			StartingHeight: p.StartingHeight,
+			SyncedHeaders:  p.SyncedHeaders,
+			SyncedBlocks:   p.SyncedBlocks,
+			PingTime:       time.Duration(p.PingTime * float64(time.Second)),
+			BytesSent:      p.BytesSent,
+			BytesReceived:  p.BytesRecv,
+			Services:       p.Services,
+			ServicesNames:  p.ServicesNames,
+			Version:        p.Version,
+			SubVersion:     p.SubVer,
+		}
+	}
+
+	return peers, nil
+}
+
+// BanPeer disconnects the given peer and bans its IP address for the given duration,
+// or for the node default of 24 hours if zero.
+func (c RPCClient) BanPeer(ctx context.Context, peer privatebtc.Node, duration time.Duration) error {
+	bantime := int64(duration.Seconds())
+
+	if err := c.call(ctx, nil, "setban", peer.NodeHandler().InternalIP(), "add", bantime); err != nil {
+		return fmt.Errorf("set ban: %w", err)
+	}
+
+	return nil
+}
+
+// UnbanPeer lifts the ban of the IP address of the given peer.
+func (c RPCClient) UnbanPeer(ctx context.Context, peer privatebtc.Node) error {
+	if err := c.call(ctx, nil, "setban", peer.NodeHandler().InternalIP(), "remove"); err != nil {
+		return fmt.Errorf("set ban: %w", err)
+	}
+
+	return nil
+}
+
+// SetNetworkActive enables or disables all the P2P network activity of the node.
+func (c RPCClient) SetNetworkActive(ctx context.Context, active bool) error {
+	if err := c.call(ctx, nil, "setnetworkactive", active); err != nil {
+		return fmt.Errorf("set network active: %w", err)
+	}
+
+	return nil
+}
+
+// GetBalance returns the balance of the wallet.
+func (c RPCClient) GetBalance(ctx context.Context) (privatebtc.Balance, error) {
+	// nolint: tagliatelle
+	var balances struct {
+		Mine struct {
+			Trusted          amount `json:"trusted"`
+			UntrustedPending amount `json:"untrusted_pending"`
+			Immature         amount `json:"immature"`
+		} `json:"mine"`
+	}
+
+	if err := c.call(ctx, &balances, "getbalances"); err != nil {
+		return privatebtc.Balance{}, err
+	}
+
+	return privatebtc.Balance{
+		Trusted:  privatebtc.Amount(balances.Mine.Trusted),
+		Pending:  privatebtc.Amount(balances.Mine.UntrustedPending),
+		Immature: privatebtc.Amount(balances.Mine.Immature),
+	}, nil
+}
+
+// GetTransaction returns a transaction by its hash.
+func (c RPCClient) GetTransaction(
+	ctx context.Context,
+	txHash string,
+) (*privatebtc.Transaction, error) {
+	var tx rawTransaction
+
+	if err := c.call(ctx, &tx, "getrawtransaction", txHash, true); err != nil {
+		return nil, fmt.Errorf("get tx request: %w", err)
+	}
+
+	return tx.toTransaction(), nil
+}
+
+// ListAddresses returns all addresses in the wallet.
+func (c RPCClient) ListAddresses(ctx context.Context) ([]string, error) {
+	var resp []struct {
+		Address string `json:"address"`
+	}
+
+	if err := c.call(ctx, &resp, "listreceivedbyaddress", 1, true); err != nil {
+		return nil, fmt.Errorf("list addresses: %w", err)
+	}
+
+	addresses := make([]string, len(resp))
+
+	for i := range resp {
+		addresses[i] = resp[i].Address
+	}
+
+	return addresses, nil
+}
+
+// GetBestBlockHash returns the hash of the best (tip) block in the longest block chain.
+func (c RPCClient) GetBestBlockHash(ctx context.Context) (string, error) {
+	var hash string
+
+	if err := c.call(ctx, &hash, "getbestblockhash"); err != nil {
+		return "", err
+	}
+
+	return hash, nil
+}
+
+// GetCoinbaseValue returns the coinbase for the next block.
+func (c RPCClient) GetCoinbaseValue(ctx context.Context) (privatebtc.Amount, error) {
+	var res struct {
+		CoinbaseValue int64 `json:"coinbasevalue"`
+	}
+
+	templateRequest := map[string]any{
+		"mode":         "template",
+		"capabilities": []string{"coinbasevalue"},
+		"rules":        []string{"segwit"},
+	}
+
+	if err := c.call(ctx, &res, "getblocktemplate", templateRequest); err != nil {
+		return 0, fmt.Errorf("get block template: %w", err)
+	}
+
+	return privatebtc.Amount(res.CoinbaseValue), nil
+}
+
+// GetTransactionOutputs returns the outputs of a mempool transaction.
+func (c RPCClient) GetTransactionOutputs(
+	ctx context.Context,
+	txHash string,
+) ([]privatebtc.MempoolTransactionOutput, error) {
+	var tx rawTransaction
+
+	if err := c.call(ctx, &tx, "getrawtransaction", txHash, true); err != nil {
+		if isTxNotInMempoolError(err) {
+			return nil, fmt.Errorf("tx %q: %w", txHash, privatebtc.ErrTxNotFoundInMempool)
+		}
+
+		return nil, fmt.Errorf("get transaction: %w", err)
+	}
+
+	return tx.outputs(), nil
+}
+
+// blockHeader is the verbose block header of the getblockheader and getblock RPCs.
+// nolint: tagliatelle
+type blockHeader struct {
+	Hash              string `json:"hash"`
+	Height            int    `json:"height"`
+	Confirmations     int    `json:"confirmations"`
+	PreviousBlockHash string `json:"previousblockhash"`
+	NextBlockHash     string `json:"nextblockhash"`
+	MerkleRoot        string `json:"merkleroot"`
+	Time              int64  `json:"time"`
+}
+
+func (h blockHeader) toBlockHeader() privatebtc.BlockHeader {
+	return privatebtc.BlockHeader{
+		Hash:              h.Hash,
+		Height:            h.Height,
+		Confirmations:     h.Confirmations,
+		PreviousBlockHash: h.PreviousBlockHash,
+		NextBlockHash:     h.NextBlockHash,
+		MerkleRoot:        h.MerkleRoot,
+		Time:              time.Unix(h.Time, 0),
+	}
+}
+
+// GetBlock returns the block with the given hash along with its transactions.
+func (c RPCClient) GetBlock(ctx context.Context, blockHash string) (*privatebtc.Block, error) {
+	const verbosityWithTxs = 2
+
+	var block struct {
+		blockHeader
+		Tx []rawTransaction `json:"tx"`
+	}
+
+	if err := c.call(ctx, &block, "getblock", blockHash, verbosityWithTxs); err != nil {
+		return nil, fmt.Errorf("get block: %w", err)
+	}
+
+	txs := make([]privatebtc.Transaction, len(block.Tx))
+
+	for i := range block.Tx {
+		// the block transactions do not carry the block hash.
+		block.Tx[i].BlockHash = block.Hash
+
+		txs[i] = *block.Tx[i].toTransaction()
+	}
+
+	return &privatebtc.Block{
+		BlockHeader:  block.toBlockHeader(),
+		Transactions: txs,
+	}, nil
+}
+
+// GetBlockHeader returns the header of the block with the given hash.
+func (c RPCClient) GetBlockHeader(
+	ctx context.Context,
+	blockHash string,
+) (*privatebtc.BlockHeader, error) {
+	var header blockHeader
+
+	if err := c.call(ctx, &header, "getblockheader", blockHash, true); err != nil {
+		return nil, fmt.Errorf("get block header: %w", err)
+	}
+
+	h := header.toBlockHeader()
+
+	return &h, nil
+}
+
+// GetBlockHash returns the hash of the block at the given height in the active chain.
+func (c RPCClient) GetBlockHash(ctx context.Context, height int) (string, error) {
+	var hash string
+
+	if err := c.call(ctx, &hash, "getblockhash", height); err != nil {
+		return "", fmt.Errorf("get block hash: %w", err)
+	}
+
+	return hash, nil
+}
+
+// GetChainTips returns the tips of all the chains known by the node.
+func (c RPCClient) GetChainTips(ctx context.Context) ([]privatebtc.ChainTip, error) {
+	var res []struct {
+		Height    int    `json:"height"`
+		Hash      string `json:"hash"`
+		BranchLen int    `json:"branchlen"`
+		Status    string `json:"status"`
+	}
+
+	if err := c.call(ctx, &res, "getchaintips"); err != nil {
+		return nil, fmt.Errorf("get chain tips: %w", err)
+	}
+
+	tips := make([]privatebtc.ChainTip, len(res))
+
+	for i := range res {
+		tips[i] = privatebtc.ChainTip{
+			Hash:      res[i].Hash,
+			Height:    res[i].Height,
+			BranchLen: res[i].BranchLen,
+			Status:    privatebtc.ChainTipStatus(res[i].Status),
+		}
+	}
+
+	return tips, nil
+}
+
+// SendRawTransaction broadcasts the given hex encoded signed transaction.
+func (c RPCClient) SendRawTransaction(ctx context.Context, txHex string) (string, error) {
+	var txHash string
+
+	// a max fee rate of 0 allows any fee.
+	if err := c.call(ctx, &txHash, "sendrawtransaction", txHex, 0); err != nil {
+		return "", fmt.Errorf("send raw transaction: %w", err)
+	}
+
+	return txHash, nil
+}
+
+// TestMempoolAccept tests whether the given transactions would be accepted into the mempool,
+// the transactions fee rate is not capped.
+func (c RPCClient) TestMempoolAccept(
+	ctx context.Context,
+	txHexes []string,
+) ([]privatebtc.MempoolAcceptResult, error) {
+	var res []struct {
+		TxID         string `json:"txid"`
+		WTxID        string `json:"wtxid"`
+		PackageError string `json:"package-error"`
+		Allowed      bool   `json:"allowed"`
+		VSize        int    `json:"vsize"`
+		Fees         struct {
+			Base amount `json:"base"`
+		} `json:"fees"`
+		RejectReason string `json:"reject-reason"`
+	}
+
+	// a max fee rate of 0 allows any fee.
+	if err := c.call(ctx, &res, "testmempoolaccept", txHexes, 0); err != nil {
+		return nil, fmt.Errorf("test mempool accept: %w", err)
+	}
+
+	results := make([]privatebtc.MempoolAcceptResult, len(res))
+
+	for i, r := range res {
+		results[i] = privatebtc.MempoolAcceptResult{
+			TxID:         r.TxID,
+			WTxID:        r.WTxID,
+			Allowed:      r.Allowed,
+			VSize:        r.VSize,
+			Fee:          privatebtc.Amount(r.Fees.Base),
+			RejectReason: privatebtc.RejectReason(r.RejectReason),
+			PackageError: r.PackageError,
+		}
+	}
+
+	return results, nil
+}
+
+// SubmitPackage broadcasts the given transactions as a package.
+func (c RPCClient) SubmitPackage(ctx context.Context, txHexes []string) (*privatebtc.PackageSubmitResult, error) {
+	// nolint: tagliatelle
+	var res struct {
+		PackageMsg string `json:"package_msg"`
+		TxResults  map[string]struct {
+			TxID       string `json:"txid"`
+			OtherWTxID string `json:"other-wtxid"`
+			VSize      int    `json:"vsize"`
+			Fees       struct {
+				Base amount `json:"base"`
+			} `json:"fees"`
+			Error string `json:"error"`
+		} `json:"tx-results"`
+		ReplacedTransactions []string `json:"replaced-transactions"`
+	}
+
+	if err := c.call(ctx, &res, "submitpackage", txHexes); err != nil {
+		return nil, fmt.Errorf("submit package: %w", err)
+	}
+
+	txResults := make(map[string]privatebtc.PackageTransactionResult, len(res.TxResults))
+
+	for wtxid, r := range res.TxResults {
+		txResults[wtxid] = privatebtc.PackageTransactionResult{
+			TxID:       r.TxID,
+			OtherWTxID: r.OtherWTxID,
+			VSize:      r.VSize,
+			Fee:        privatebtc.Amount(r.Fees.Base),
+			Error:      privatebtc.RejectReason(r.Error),
+		}
+	}
+
+	return &privatebtc.PackageSubmitResult{
+		Message:              res.PackageMsg,
+		Transactions:         txResults,
+		ReplacedTransactions: res.ReplacedTransactions,
+	}, nil
+}
+
+// WalletCreateFundedPSBT creates a PSBT paying the given amounts, funded by the wallet,
+// paying the fee as configured by the given options.
+func (c RPCClient) WalletCreateFundedPSBT(
+	ctx context.Context,
+	inputs []privatebtc.TransactionVin,
+	amounts map[string]privatebtc.Amount,
+	opts privatebtc.SendOptions,
+) (*privatebtc.FundedPSBT, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	outputs := make(map[string]amount, len(amounts))
+
+	for addr, amnt := range amounts {
+		outputs[addr] = amount(amnt)
+	}
+
+	options := map[string]any{}
+
+	if opts.FeeRate != 0 {
+		options["fee_rate"] = float64(opts.FeeRate)
+	}
+
+	if opts.ConfTarget != 0 {
+		options["conf_target"] = opts.ConfTarget
+	}
+
+	if subtractFeeFromOutputs := opts.SubtractFeeFromOutputs(len(outputs)); subtractFeeFromOutputs != nil {
+		options["subtractFeeFromOutputs"] = subtractFeeFromOutputs
+	}
+
+	if opts.Replaceable {
+		options["replaceable"] = true
+	}
+
+	if opts.ChangeAddress != "" {
+		options["changeAddress"] = opts.ChangeAddress
+	}
+
+	psbtInputs := txInputs(inputs)
+
+	for i := range psbtInputs {
+		if psbtInputs[i].Sequence == 0 {
+			psbtInputs[i].Sequence = opts.InputSequence()
+		}
+	}
+
+	var res struct {
+		PSBT      string `json:"psbt"`
+		Fee       amount `json:"fee"`
+		ChangePos int    `json:"changepos"`
+	}
+
+	if err := c.call(ctx, &res, "walletcreatefundedpsbt", psbtInputs, outputs, opts.LockTime, options); err != nil {
+		return nil, fmt.Errorf("wallet create funded psbt: %w", err)
+	}
+
+	return &privatebtc.FundedPSBT{
+		PSBT:      res.PSBT,
+		Fee:       privatebtc.Amount(res.Fee),
+		ChangePos: res.ChangePos,
+	}, nil
+}
+
+// maxConfirmations is the listunspent maxconf default value, used when the
+// filter has no maximum.
+const maxConfirmations = 9999999
+
+// ListUnspent returns the wallet UTXOs matching the given filter.
+func (c RPCClient) ListUnspent(
+	ctx context.Context,
+	filter privatebtc.UTXOFilter,
+) ([]privatebtc.UTXO, error) {
+	maxConf := filter.MaxConf
+	if maxConf == 0 {
+		maxConf = maxConfirmations
+	}
+
+	addresses := filter.Addresses
+	if addresses == nil {
+		addresses = []string{}
+	}
+
+	var res []struct {
+		TxID          string `json:"txid"`
+		Vout          uint32 `json:"vout"`
+		Address       string `json:"address"`
+		Label         string `json:"label"`
+		Amount        amount `json:"amount"`
+		Confirmations int    `json:"confirmations"`
+		Spendable     bool   `json:"spendable"`
+		Safe          bool   `json:"safe"`
+	}
+
+	if err := c.call(ctx, &res, "listunspent", filter.MinConf, maxConf, addresses); err != nil {
+		return nil, fmt.Errorf("list unspent: %w", err)
+	}
+
+	utxos := make([]privatebtc.UTXO, len(res))
+
+	for i, u := range res {
+		utxos[i] = privatebtc.UTXO{
+			TxID:          u.TxID,
+			Vout:          u.Vout,
+			Address:       u.Address,
+			Label:         u.Label,
+			Amount:        privatebtc.Amount(u.Amount),
+			Confirmations: u.Confirmations,
+			Spendable:     u.Spendable,
+			Safe:          u.Safe,
+		}
+	}
+
+	return utxos, nil
+}
+
+// LockUnspent locks the given UTXOs, so that the wallet does not select them
+// when funding transactions.
+func (c RPCClient) LockUnspent(ctx context.Context, outpoints []privatebtc.TransactionVin) error {
+	if err := c.call(ctx, nil, "lockunspent", false, txInputs(outpoints)); err != nil {
+		return fmt.Errorf("lock unspent: %w", err)
+	}
+
+	return nil
+}
+
+// UnlockUnspent unlocks the given UTXOs, or every locked UTXO if none are given.
+func (c RPCClient) UnlockUnspent(ctx context.Context, outpoints []privatebtc.TransactionVin) error {
+	params := []any{true}
+
+	// without outpoints, the whole wallet is unlocked.
+	if len(outpoints) != 0 {
+		params = append(params, txInputs(outpoints))
+	}
+
+	if err := c.call(ctx, nil, "lockunspent", params...); err != nil {
+		return fmt.Errorf("unlock unspent: %w", err)
+	}
+
+	return nil
+}
+
+// ListLockUnspent returns the locked UTXOs.
+func (c RPCClient) ListLockUnspent(ctx context.Context) ([]privatebtc.TransactionVin, error) {
+	var res []txInput
+
+	if err := c.call(ctx, &res, "listlockunspent"); err != nil {
+		return nil, fmt.Errorf("list lock unspent: %w", err)
+	}
+
+	outpoints := make([]privatebtc.TransactionVin, len(res))
+	for i := range res {
+		outpoints[i] = privatebtc.TransactionVin{TxID: res[i].TxID, Vout: res[i].Vout}
+	}
+
+	return outpoints, nil
+}
+
+// WalletProcessPSBT updates the given PSBT with the wallet data and signs its
+// inputs if sign is true.
+func (c RPCClient) WalletProcessPSBT(
+	ctx context.Context,
+	psbt string,
+	sign bool,
+) (*privatebtc.ProcessedPSBT, error) {
+	var res struct {
+		PSBT     string `json:"psbt"`
+		Complete bool   `json:"complete"`
+	}
+
+	if err := c.call(ctx, &res, "walletprocesspsbt", psbt, sign); err != nil {
+		return nil, fmt.Errorf("wallet process psbt: %w", err)
+	}
+
+	return &privatebtc.ProcessedPSBT{
+		PSBT:     res.PSBT,
+		Complete: res.Complete,
+	}, nil
+}
+
+// CombinePSBT combines the given PSBTs of the same transaction into a single PSBT.
+func (c RPCClient) CombinePSBT(ctx context.Context, psbts []string) (string, error) {
+	var combined string
+
+	if err := c.call(ctx, &combined, "combinepsbt", psbts); err != nil {
+		return "", fmt.Errorf("combine psbt: %w", err)
+	}
+
+	return combined, nil
+}
+
+// FinalizePSBT finalizes the given PSBT, extracting the network transaction if
+// the PSBT is complete.
+func (c RPCClient) FinalizePSBT(ctx context.Context, psbt string) (*privatebtc.FinalizedPSBT, error) {
+	var res struct {
+		PSBT     string `json:"psbt"`
+		Hex      string `json:"hex"`
+		Complete bool   `json:"complete"`
+	}
+
+	if err := c.call(ctx, &res, "finalizepsbt", psbt, true); err != nil {
+		return nil, fmt.Errorf("finalize psbt: %w", err)
+	}
+
+	return &privatebtc.FinalizedPSBT{
+		PSBT:     res.PSBT,
+		Hex:      res.Hex,
+		Complete: res.Complete,
+	}, nil
+}
+
+// AnalyzePSBT analyzes the given PSBT and returns the next role of the workflow.
+func (c RPCClient) AnalyzePSBT(ctx context.Context, psbt string) (*privatebtc.PSBTAnalysis, error) {
+	// nolint: tagliatelle
+	var res struct {
+		Inputs []struct {
+			HasUTXO bool `json:"has_utxo"`
+			IsFinal bool `json:"is_final"`
+			Missing struct {
+				PubKeys       []string `json:"pubkeys"`
+				Signatures    []string `json:"signatures"`
+				RedeemScript  string   `json:"redeemscript"`
+				WitnessScript string   `json:"witnessscript"`
+			} `json:"missing"`
+			Next string `json:"next"`
+		} `json:"inputs"`
+		EstimatedVSize   int    `json:"estimated_vsize"`
+		EstimatedFeeRate amount `json:"estimated_feerate"`
+		Fee              amount `json:"fee"`
+		Next             string `json:"next"`
+		Error            string `json:"error"`
+	}
+
+	if err := c.call(ctx, &res, "analyzepsbt", psbt); err != nil {
+		return nil, fmt.Errorf("analyze psbt: %w", err)
+	}
+
+	inputs := make([]privatebtc.PSBTInputAnalysis, len(res.Inputs))
+
+	for i, in := range res.Inputs {
+		inputs[i] = privatebtc.PSBTInputAnalysis{
+			HasUTXO:              in.HasUTXO,
+			IsFinal:              in.IsFinal,
+			MissingPubKeys:       in.Missing.PubKeys,
+			MissingSignatures:    in.Missing.Signatures,
+			MissingRedeemScript:  in.Missing.RedeemScript,
+			MissingWitnessScript: in.Missing.WitnessScript,
+			Next:                 privatebtc.PSBTRole(in.Next),
+		}
+	}
+
+	// the estimated fee rate is expressed in BTC/kvB.
+	feeRate := privatebtc.NewFeeRate(privatebtc.Amount(res.EstimatedFeeRate), 1000)
+
+	return &privatebtc.PSBTAnalysis{
+		Inputs:           inputs,
+		EstimatedVSize:   res.EstimatedVSize,
+		EstimatedFeeRate: feeRate,
+		Fee:              privatebtc.Amount(res.Fee),
+		Next:             privatebtc.PSBTRole(res.Next),
+		Error:            res.Error,
+	}, nil
+}
+
+// BumpFee replaces the given wallet transaction with a transaction paying the
+// given fee rate, keeping its outputs and reducing its change.
+func (c RPCClient) BumpFee(
+	ctx context.Context,
+	txHash string,
+	feeRate privatebtc.FeeRate,
+) (*privatebtc.BumpedTransaction, error) {
+	return c.bumpFee(ctx, "bumpfee", txHash, feeRate)
+}
+
+// PSBTBumpFee creates an unsigned PSBT replacing the given wallet transaction with
+// a transaction paying the given fee rate.
+func (c RPCClient) PSBTBumpFee(
+	ctx context.Context,
+	txHash string,
+	feeRate privatebtc.FeeRate,
+) (*privatebtc.BumpedTransaction, error) {
+	return c.bumpFee(ctx, "psbtbumpfee", txHash, feeRate)
+}
+
+// bumpFee calls the bumpfee or the psbtbumpfee RPC, which share their arguments
+// and differ only in returning the replacement txid or PSBT.
+func (c RPCClient) bumpFee(
+	ctx context.Context,
+	method string,
+	txHash string,
+	feeRate privatebtc.FeeRate,
+) (*privatebtc.BumpedTransaction, error) {
+	// nolint: tagliatelle
+	type options struct {
+		FeeRate float64 `json:"fee_rate"`
+	}
+
+	// nolint: tagliatelle
+	var res struct {
+		TxID    string `json:"txid"`
+		PSBT    string `json:"psbt"`
+		OrigFee amount `json:"origfee"`
+		Fee     amount `json:"fee"`
+	}
+
+	if err := c.call(ctx, &res, method, txHash, options{FeeRate: float64(feeRate)}); err != nil {
+		return nil, fmt.Errorf("%s: %w", method, err)
+	}
+
+	return &privatebtc.BumpedTransaction{
+		TxID:        res.TxID,
+		PSBT:        res.PSBT,
+		OriginalFee: privatebtc.Amount(res.OrigFee),
+		Fee:         privatebtc.Amount(res.Fee),
+	}, nil
+}
+
+// ListDescriptors returns the descriptors of the wallet, including their private
+// keys if private is true.
+func (c RPCClient) ListDescriptors(ctx context.Context, private bool) ([]privatebtc.Descriptor, error) {
+	var res struct {
+		Descriptors []struct {
+			Desc      string  `json:"desc"`
+			Timestamp int64   `json:"timestamp"`
+			Active    bool    `json:"active"`
+			Internal  bool    `json:"internal"`
+			Range     *[2]int `json:"range"`
+			Next      int     `json:"next"`
+		} `json:"descriptors"`
+	}
+
+	if err := c.call(ctx, &res, "listdescriptors", private); err != nil {
+		return nil, fmt.Errorf("list descriptors: %w", err)
+	}
+
+	descriptors := make([]privatebtc.Descriptor, len(res.Descriptors))
+
+	for i, d := range res.Descriptors {
+		descriptors[i] = privatebtc.Descriptor{
+			Desc:      d.Desc,
+			Timestamp: time.Unix(d.Timestamp, 0),
+			Active:    d.Active,
+			Internal:  d.Internal,
+			Range:     d.Range,
+			Next:      d.Next,
+		}
+	}
+
+	return descriptors, nil
+}
+
+// ImportDescriptors imports the given descriptors into the wallet.
+func (c RPCClient) ImportDescriptors(
+	ctx context.Context,
+	requests []privatebtc.ImportDescriptorRequest,
+) error {
+	type importRequest struct {
+		Desc      string  `json:"desc"`
+		Active    bool    `json:"active"`
+		Internal  bool    `json:"internal"`
+		Range     *[2]int `json:"range,omitempty"`
+		Label     string  `json:"label,omitempty"`
+		Timestamp any     `json:"timestamp"`
+	}
+
+	reqs := make([]importRequest, len(requests))
+
+	for i, r := range requests {
+		var timestamp any = "now"
+		if !r.Timestamp.IsZero() {
+			timestamp = r.Timestamp.Unix()
+		}
+
+		reqs[i] = importRequest{
+			Desc:      r.Desc,
+			Active:    r.Active,
+			Internal:  r.Internal,
+			Range:     r.Range,
+			Label:     r.Label,
+			Timestamp: timestamp,
+		}
+	}
+
+	var results []struct {
+		Success bool   `json:"success"`
+		Error   *Error `json:"error"`
+	}
+
+	if err := c.call(ctx, &results, "importdescriptors", reqs); err != nil {
+		return fmt.Errorf("import descriptors: %w", err)
+	}
+
+	var errs error
+
+	for i, res := range results {
+		if res.Success {
+			continue
+		}
+
+		var msg string
+		if res.Error != nil {
+			msg = res.Error.Message
+		}
+
+		errs = errors.Join(errs, fmt.Errorf(
+			"descriptor %d: %s: %w",
+			i,
+			msg,
+			privatebtc.ErrImportDescriptorFailed,
+		))
+	}
+
+	return errs
+}
+
+// GetDescriptorInfo analyzes the given descriptor.
+func (c RPCClient) GetDescriptorInfo(
+	ctx context.Context,
+	descriptor string,
+) (*privatebtc.DescriptorInfo, error) {
+	// nolint: tagliatelle
+	var res struct {
+		Descriptor     string `json:"descriptor"`
+		Checksum       string `json:"checksum"`
+		IsRange        bool   `json:"isrange"`
+		IsSolvable     bool   `json:"issolvable"`
+		HasPrivateKeys bool   `json:"hasprivatekeys"`
+	}
+
+	if err := c.call(ctx, &res, "getdescriptorinfo", descriptor); err != nil {
+		return nil, fmt.Errorf("get descriptor info: %w", err)
+	}
+
+	return &privatebtc.DescriptorInfo{
+		Descriptor:     res.Descriptor,
+		Checksum:       res.Checksum,
+		IsRange:        res.IsRange,
+		IsSolvable:     res.IsSolvable,
+		HasPrivateKeys: res.HasPrivateKeys,
+	}, nil
+}
+
+// DeriveAddress returns the address of the given descriptor, which must not be ranged.
+func (c RPCClient) DeriveAddress(ctx context.Context, descriptor string) (string, error) {
+	var addresses []string
+
+	if err := c.call(ctx, &addresses, "deriveaddresses", descriptor); err != nil {
+		return "", fmt.Errorf("derive addresses: %w", err)
+	}
+
+	if len(addresses) == 0 {
+		return "", privatebtc.ErrDescriptorWithoutAddress
+	}
+
+	return addresses[0], nil
+}
+
+// GetAddressInfo returns the information the wallet has about the given address.
+func (c RPCClient) GetAddressInfo(ctx context.Context, address string) (*privatebtc.AddressInfo, error) {
+	// nolint: tagliatelle
+	var res struct {
+		Address   string `json:"address"`
+		IsMine    bool   `json:"ismine"`
+		Desc      string `json:"desc"`
+		HDKeyPath string `json:"hdkeypath"`
+	}
+
+	if err := c.call(ctx, &res, "getaddressinfo", address); err != nil {
+		return nil, fmt.Errorf("get address info: %w", err)
+	}
+
+	return &privatebtc.AddressInfo{
+		Address:    res.Address,
+		IsMine:     res.IsMine,
+		Descriptor: res.Desc,
+		HDKeyPath:  res.HDKeyPath,
+	}, nil
+}
+
+// CreateMultisig creates a P2WSH multisig address requiring nRequired signatures
+// of the given hex encoded public keys.
+func (c RPCClient) CreateMultisig(
+	ctx context.Context,
+	nRequired int,
+	pubKeys []string,
+) (*privatebtc.Multisig, error) {
+	var res struct {
+		Address      string `json:"address"`
+		RedeemScript string `json:"redeemScript"`
+		Descriptor   string `json:"descriptor"`
+	}
+
+	if err := c.call(ctx, &res, "createmultisig", nRequired, pubKeys, "bech32"); err != nil {
+		return nil, fmt.Errorf("create multisig: %w", err)
+	}
+
+	return &privatebtc.Multisig{
+		Address:      res.Address,
+		RedeemScript: res.RedeemScript,
+		Descriptor:   res.Descriptor,
+	}, nil
+}
+
+// GetTransactionsOutputs returns the outputs of the given transactions, fetched
+// in a single batch request.
+func (c RPCClient) GetTransactionsOutputs(
+	ctx context.Context,
+	txHashes []string,
+) ([][]privatebtc.MempoolTransactionOutput, error) {
+	txs := make([]rawTransaction, len(txHashes))
+	calls := make([]*Call, len(txHashes))
+
+	for i, txHash := range txHashes {
+		calls[i] = NewCall(&txs[i], "getrawtransaction", txHash, true)
+	}
+
+	if err := c.Batch(ctx, calls...); err != nil {
+		return nil, fmt.Errorf("get transactions: %w", err)
+	}
+
+	outputs := make([][]privatebtc.MempoolTransactionOutput, len(txHashes))
+
+	for i := range calls {
+		// the transaction left the mempool since it was listed.
+		if isTxNotInMempoolError(calls[i].Err) {
+			continue
+		}
+
+		if calls[i].Err != nil {
+			return nil, fmt.Errorf("get transaction %q: %w", txHashes[i], calls[i].Err)
+		}
+
+		outputs[i] = txs[i].outputs()
+	}
+
+	return outputs, nil
+}
+
+// GetMempoolEntries returns the mempool entries of the given transactions, fetched
+// in a single batch request.
+func (c RPCClient) GetMempoolEntries(
+	ctx context.Context,
+	txHashes []string,
+) ([]*privatebtc.MempoolEntry, error) {
+	res := make([]mempoolEntry, len(txHashes))
+	calls := make([]*Call, len(txHashes))
+
+	for i, txHash := range txHashes {
+		calls[i] = NewCall(&res[i], "getmempoolentry", txHash)
+	}
+
+	if err := c.Batch(ctx, calls...); err != nil {
+		return nil, fmt.Errorf("get mempool entries: %w", err)
+	}
+
+	entries := make([]*privatebtc.MempoolEntry, len(txHashes))
+
+	for i := range calls {
+		// the transaction left the mempool since it was listed.
+		if isTxNotInMempoolError(calls[i].Err) {
+			continue
+		}
+
+		if calls[i].Err != nil {
+			return nil, fmt.Errorf("get mempool entry %q: %w", txHashes[i], calls[i].Err)
+		}
+
+		entries[i] = res[i].toMempoolEntry()
+	}
+
+	return entries, nil
+}
+
+var _ privatebtc.RPCClientFactory = (*RPCClientFactory)(nil)
+
+// RPCClientFactory is a factory for RPC clients.
+type RPCClientFactory struct {
+	NoPing bool
+	// HTTPClient is the client used to send the requests,
+	// http.DefaultClient is used when nil.
+	HTTPClient *http.Client
+}
+
+// NewRPCClient creates a new RPC client, pinging the node unless NoPing is set.
+func (f RPCClientFactory) NewRPCClient(
+	ctx context.Context,
+	hostPort,
+	rpcUser,
+	rpcPass string,
+) (privatebtc.RPCClient, error) {
+	httpClient := f.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	c := RPCClient{
+		url:        "http://localhost:" + hostPort,
+		baseURL:    "http://localhost:" + hostPort,
+		user:       rpcUser,
+		pass:       rpcPass,
+		httpClient: httpClient,
+		nextID:     new(atomic.Uint64),
+	}
+
+	if f.NoPing {
+		return c, nil
+	}
+
+	if err := c.call(ctx, nil, "ping"); err != nil {
+		return nil, fmt.Errorf("ping: %w", err)
+	}
+
+	return c, nil
+}
@@ -0,0 +1,789 @@
+package jsonrpc_test
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/adrianbrad/privatebtc"
+	"github.com/adrianbrad/privatebtc/jsonrpc"
+	"github.com/stretchr/testify/require"
+)
+
+type rpcRequest struct {
+	ID     uint64            `json:"id"`
+	Method string            `json:"method"`
+	Params []json.RawMessage `json:"params"`
+}
+
+type rpcResponse struct {
+	ID     uint64         `json:"id"`
+	Result any            `json:"result"`
+	Error  *jsonrpc.Error `json:"error"`
+}
+
+// rpcHandler handles a single RPC call of the fake node.
+type rpcHandler func(method string, params []json.RawMessage) (any, *jsonrpc.Error)
+
+// newFakeNode starts a fake node which answers the single and the batch requests
+// using the given handler, returning its port and the number of HTTP requests
+// it received.
+func newFakeNode(t *testing.T, handler rpcHandler) (string, *atomic.Int64) {
+	t.Helper()
+
+	var requests atomic.Int64
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+
+		if user, pass, ok := r.BasicAuth(); !ok || user != "user" || pass != "pass" {
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		var body json.RawMessage
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		respond := func(req rpcRequest) rpcResponse {
+			result, rpcErr := handler(req.Method, req.Params)
+
+			return rpcResponse{ID: req.ID, Result: result, Error: rpcErr}
+		}
+
+		var batch []rpcRequest
+		if err := json.Unmarshal(body, &batch); err == nil {
+			resps := make([]rpcResponse, len(batch))
+
+			// respond in reverse order, the batch responses are not ordered.
+			for i := range batch {
+				resps[len(batch)-1-i] = respond(batch[i])
+			}
+
+			_ = json.NewEncoder(w).Encode(resps)
+
+			return
+		}
+
+		var req rpcRequest
+		if err := json.Unmarshal(body, &req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		resp := respond(req)
+		if resp.Error != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+
+	t.Cleanup(srv.Close)
+
+	_, port, err := net.SplitHostPort(srv.Listener.Addr().String())
+	require.NoError(t, err)
+
+	return port, &requests
+}
+
+// newFakeNodeRPCClient returns an RPC client connected to a fake node, see newFakeNode.
+func newFakeNodeRPCClient(t *testing.T, handler rpcHandler) (jsonrpc.RPCClient, *atomic.Int64) {
+	t.Helper()
+
+	port, requests := newFakeNode(t, handler)
+
+	c, err := jsonrpc.RPCClientFactory{}.NewRPCClient(context.Background(), port, "user", "pass")
+	require.NoError(t, err)
+
+	// the ping request.
+	require.Equal(t, int64(1), requests.Load())
+
+	requests.Store(0)
+
+	return c.(jsonrpc.RPCClient), requests
+}
+
+func TestRPCClient(t *testing.T) {
+	t.Parallel()
+
+	t.Run("GetBalance", func(t *testing.T) {
+		t.Parallel()
+
+		c, _ := newFakeNodeRPCClient(t, func(method string, _ []json.RawMessage) (any, *jsonrpc.Error) {
+			if method != "getbalances" {
+				return nil, nil
+			}
+
+			return json.RawMessage(`{"mine":{` +
+				`"trusted":20999999.99999999,` +
+				`"untrusted_pending":0.00100000,` +
+				`"immature":5000.00000000}}`), nil
+		})
+
+		balance, err := c.GetBalance(context.Background())
+		require.NoError(t, err)
+
+		require.Equal(t, privatebtc.Balance{
+			Trusted:  21_000_000*privatebtc.BTC - privatebtc.Satoshi,
+			Pending:  privatebtc.BTC / 1000,
+			Immature: 5000 * privatebtc.BTC,
+		}, balance)
+	})
+
+	t.Run("SendToAddress", func(t *testing.T) {
+		t.Parallel()
+
+		var params []json.RawMessage
+
+		c, _ := newFakeNodeRPCClient(t, func(method string, p []json.RawMessage) (any, *jsonrpc.Error) {
+			if method == "sendtoaddress" {
+				params = p
+			}
+
+			return "txhash", nil
+		})
+
+		txHash, err := c.SendToAddress(
+			context.Background(),
+			"addr",
+			privatebtc.BTC/10+privatebtc.Satoshi,
+			privatebtc.SendOptions{},
+		)
+		require.NoError(t, err)
+
+		require.Equal(t, "txhash", txHash)
+		require.Equal(t, []json.RawMessage{
+			json.RawMessage(`"addr"`),
+			json.RawMessage(`0.10000001`),
+		}, params)
+	})
+
+	t.Run("SendWithOptions", func(t *testing.T) {
+		t.Parallel()
+
+		var params []json.RawMessage
+
+		c, _ := newFakeNodeRPCClient(t, func(method string, p []json.RawMessage) (any, *jsonrpc.Error) {
+			if method == "send" {
+				params = p
+			}
+
+			return map[string]any{"complete": true, "txid": "txhash"}, nil
+		})
+
+		txHash, err := c.SendToAddress(context.Background(), "addr", privatebtc.BTC/10, privatebtc.SendOptions{
+			FeeRate:               2.5,
+			SubtractFeeFromAmount: true,
+			Replaceable:           true,
+			ChangeAddress:         "change",
+		})
+		require.NoError(t, err)
+
+		require.Equal(t, "txhash", txHash)
+		require.Len(t, params, 5)
+		require.JSONEq(t, `[{"addr":0.10000000}]`, string(params[0]))
+		require.JSONEq(t, `{"fee_rate":2.5,"subtract_fee_from_outputs":[0],`+
+			`"replaceable":true,"change_address":"change"}`, string(params[4]))
+	})
+
+	t.Run("ConflictingFeeOptions", func(t *testing.T) {
+		t.Parallel()
+
+		c, requests := newFakeNodeRPCClient(t, func(string, []json.RawMessage) (any, *jsonrpc.Error) {
+			return nil, nil
+		})
+
+		opts := privatebtc.SendOptions{FeeRate: 2, ConfTarget: 6}
+
+		_, err := c.SendToAddress(context.Background(), "addr", privatebtc.BTC, opts)
+		require.ErrorIs(t, err, privatebtc.ErrConflictingFeeOptions)
+
+		_, err = c.WalletCreateFundedPSBT(context.Background(), nil, map[string]privatebtc.Amount{"addr": 1}, opts)
+		require.ErrorIs(t, err, privatebtc.ErrConflictingFeeOptions)
+
+		require.Zero(t, requests.Load())
+	})
+
+	t.Run("SendCustomTransaction", func(t *testing.T) {
+		t.Parallel()
+
+		var params []json.RawMessage
+
+		c, _ := newFakeNodeRPCClient(t, func(method string, p []json.RawMessage) (any, *jsonrpc.Error) {
+			switch method {
+			case "createrawtransaction":
+				params = p
+
+				return "rawtx", nil
+			case "signrawtransactionwithwallet":
+				return map[string]any{"hex": "signedtx", "complete": true}, nil
+			default:
+				return "txhash", nil
+			}
+		})
+
+		txHash, err := c.SendCustomTransaction(
+			context.Background(),
+			[]privatebtc.TransactionVin{{TxID: "a", Vout: 0}, {TxID: "b", Vout: 1, Sequence: 10}},
+			map[string]privatebtc.Amount{"addr": privatebtc.BTC},
+			150,
+		)
+		require.NoError(t, err)
+
+		require.Equal(t, "txhash", txHash)
+		require.Len(t, params, 3)
+		require.JSONEq(t, `[{"txid":"a","vout":0},{"txid":"b","vout":1,"sequence":10}]`, string(params[0]))
+		require.JSONEq(t, `{"addr":1.00000000}`, string(params[1]))
+		require.JSONEq(t, `150`, string(params[2]))
+	})
+
+	t.Run("WalletCreateFundedPSBT", func(t *testing.T) {
+		t.Parallel()
+
+		var params []json.RawMessage
+
+		c, _ := newFakeNodeRPCClient(t, func(method string, p []json.RawMessage) (any, *jsonrpc.Error) {
+			if method != "walletcreatefundedpsbt" {
+				return nil, nil
+			}
+
+			params = p
+
+			return map[string]any{"psbt": "psbt", "fee": 0.0000141, "changepos": -1}, nil
+		})
+
+		funded, err := c.WalletCreateFundedPSBT(
+			context.Background(),
+			[]privatebtc.TransactionVin{{TxID: "a", Vout: 0}, {TxID: "b", Vout: 1, Sequence: 10}},
+			map[string]privatebtc.Amount{"addr": privatebtc.BTC},
+			privatebtc.SendOptions{LockTime: 150},
+		)
+		require.NoError(t, err)
+
+		require.Equal(t, &privatebtc.FundedPSBT{PSBT: "psbt", Fee: 1410, ChangePos: -1}, funded)
+		require.Len(t, params, 4)
+		require.JSONEq(t, `[{"txid":"a","vout":0,"sequence":4294967294},{"txid":"b","vout":1,"sequence":10}]`,
+			string(params[0]))
+		require.JSONEq(t, `150`, string(params[2]))
+	})
+
+	t.Run("ListUnspent", func(t *testing.T) {
+		t.Parallel()
+
+		var params []json.RawMessage
+
+		c, _ := newFakeNodeRPCClient(t, func(method string, p []json.RawMessage) (any, *jsonrpc.Error) {
+			if method != "listunspent" {
+				return nil, nil
+			}
+
+			params = p
+
+			return json.RawMessage(`[{"txid":"tx","vout":1,"address":"addr","label":"l",` +
+				`"amount":0.12345678,"confirmations":0,"spendable":true,"safe":false}]`), nil
+		})
+
+		utxos, err := c.ListUnspent(context.Background(), privatebtc.UTXOFilter{})
+		require.NoError(t, err)
+
+		require.Equal(t, []privatebtc.UTXO{{
+			TxID:      "tx",
+			Vout:      1,
+			Address:   "addr",
+			Label:     "l",
+			Amount:    12345678,
+			Spendable: true,
+		}}, utxos)
+		require.Equal(t, []json.RawMessage{
+			json.RawMessage(`0`),
+			json.RawMessage(`9999999`),
+			json.RawMessage(`[]`),
+		}, params)
+	})
+
+	t.Run("TestMempoolAccept", func(t *testing.T) {
+		t.Parallel()
+
+		var params []json.RawMessage
+
+		c, _ := newFakeNodeRPCClient(t, func(method string, p []json.RawMessage) (any, *jsonrpc.Error) {
+			if method != "testmempoolaccept" {
+				return nil, nil
+			}
+
+			params = p
+
+			return json.RawMessage(`[{"txid":"parent","wtxid":"wparent","allowed":true,"vsize":141,` +
+				`"fees":{"base":0.0000141,"effective-feerate":0.0001,"effective-includes":["wparent"]}},` +
+				`{"txid":"child","wtxid":"wchild","allowed":false,"reject-reason":"non-final"}]`), nil
+		})
+
+		results, err := c.TestMempoolAccept(context.Background(), []string{"parenthex", "childhex"})
+		require.NoError(t, err)
+
+		require.Equal(t, []privatebtc.MempoolAcceptResult{
+			{TxID: "parent", WTxID: "wparent", Allowed: true, VSize: 141, Fee: 1410},
+			{TxID: "child", WTxID: "wchild", RejectReason: privatebtc.RejectReasonNonFinal},
+		}, results)
+		require.Equal(t, []json.RawMessage{
+			json.RawMessage(`["parenthex","childhex"]`),
+			json.RawMessage(`0`),
+		}, params)
+	})
+
+	t.Run("SubmitPackage", func(t *testing.T) {
+		t.Parallel()
+
+		c, _ := newFakeNodeRPCClient(t, func(method string, _ []json.RawMessage) (any, *jsonrpc.Error) {
+			if method != "submitpackage" {
+				return nil, nil
+			}
+
+			return json.RawMessage(`{"package_msg":"transaction failed","tx-results":{` +
+				`"wparent":{"txid":"parent","vsize":141,"fees":{"base":0.0000141}},` +
+				`"wchild":{"txid":"child","error":"min relay fee not met"}},` +
+				`"replaced-transactions":["replaced"]}`), nil
+		})
+
+		res, err := c.SubmitPackage(context.Background(), []string{"parenthex", "childhex"})
+		require.NoError(t, err)
+
+		require.Equal(t, &privatebtc.PackageSubmitResult{
+			Message: "transaction failed",
+			Transactions: map[string]privatebtc.PackageTransactionResult{
+				"wparent": {TxID: "parent", VSize: 141, Fee: 1410},
+				"wchild":  {TxID: "child", Error: privatebtc.RejectReasonMinRelayFeeNotMet},
+			},
+			ReplacedTransactions: []string{"replaced"},
+		}, res)
+	})
+
+	t.Run("GetPeerInfo", func(t *testing.T) {
+		t.Parallel()
+
+		c, _ := newFakeNodeRPCClient(t, func(method string, _ []json.RawMessage) (any, *jsonrpc.Error) {
+			if method != "getpeerinfo" {
+				return nil, nil
+			}
+
+			return json.RawMessage(`[{"id":3,"addr":"172.17.0.3:18444","inbound":false,` +
+				`"connection_type":"manual","startingheight":101,"synced_headers":102,` +
+				`"synced_blocks":102,"pingtime":0.0015,"bytessent":1024,"bytesrecv":2048,` +
+				`"services":"0000000000000409","servicesnames":["NETWORK","WITNESS"],` +
+				`"version":70016,"subver":"/Satoshi:26.0.0/"}]`), nil
+		})
+
+		peers, err := c.GetPeerInfo(context.Background())
+		require.NoError(t, err)
+
+		require.Equal(t, []privatebtc.Peer{{
+			ID:             3,
+			Addr:           "172.17.0.3:18444",
+			ConnectionType: "manual",
+			StartingHeight: 101,
+			SyncedHeaders:  102,
+			SyncedBlocks:   102,
+			PingTime:       1500 * time.Microsecond,
+			BytesSent:      1024,
+			BytesReceived:  2048,
+			Services:       "0000000000000409",
+			ServicesNames:  []string{"NETWORK", "WITNESS"},
+			Version:        70016,
+			SubVersion:     "/Satoshi:26.0.0/",
+		}}, peers)
+	})
+
+	t.Run("SetNetworkActive", func(t *testing.T) {
+		t.Parallel()
+
+		var params []json.RawMessage
+
+		c, _ := newFakeNodeRPCClient(t, func(method string, p []json.RawMessage) (any, *jsonrpc.Error) {
+			if method == "setnetworkactive" {
+				params = p
+			}
+
+			return nil, nil
+		})
+
+		require.NoError(t, c.SetNetworkActive(context.Background(), false))
+
+		require.Equal(t, []json.RawMessage{json.RawMessage(`false`)}, params)
+	})
+
+	t.Run("GetBlock", func(t *testing.T) {
+		t.Parallel()
+
+		c, _ := newFakeNodeRPCClient(t, func(method string, _ []json.RawMessage) (any, *jsonrpc.Error) {
+			if method != "getblock" {
+				return nil, nil
+			}
+
+			return json.RawMessage(`{"hash":"block","height":102,"confirmations":-1,` +
+				`"previousblockhash":"prev","time":1700000000,"tx":[` +
+				`{"txid":"coinbase","vin":[{"coinbase":"51"}],"vout":[` +
+				`{"value":50.00000000,"n":0,"scriptPubKey":{"address":"addr"}}]}]}`), nil
+		})
+
+		block, err := c.GetBlock(context.Background(), "block")
+		require.NoError(t, err)
+
+		require.Equal(t, &privatebtc.Block{
+			BlockHeader: privatebtc.BlockHeader{
+				Hash:              "block",
+				Height:            102,
+				Confirmations:     -1,
+				PreviousBlockHash: "prev",
+				Time:              time.Unix(1700000000, 0),
+			},
+			Transactions: []privatebtc.Transaction{{
+				TxID:      "coinbase",
+				BlockHash: "block",
+				Vin:       []privatebtc.TransactionVin{{}},
+				Vout: []privatebtc.TransactionVout{{
+					Value:        50 * privatebtc.BTC,
+					ScriptPubKey: struct{ Address string }{Address: "addr"},
+				}},
+			}},
+		}, block)
+	})
+
+	t.Run("AnalyzePSBT", func(t *testing.T) {
+		t.Parallel()
+
+		c, _ := newFakeNodeRPCClient(t, func(method string, _ []json.RawMessage) (any, *jsonrpc.Error) {
+			if method != "analyzepsbt" {
+				return nil, nil
+			}
+
+			return json.RawMessage(`{"inputs":[{"has_utxo":true,"is_final":false,` +
+				`"missing":{"signatures":["keyhash"]},"next":"signer"}],` +
+				`"estimated_vsize":141,"estimated_feerate":0.00010000,"fee":0.00001410,"next":"signer"}`), nil
+		})
+
+		analysis, err := c.AnalyzePSBT(context.Background(), "psbt")
+		require.NoError(t, err)
+
+		require.Equal(t, &privatebtc.PSBTAnalysis{
+			Inputs: []privatebtc.PSBTInputAnalysis{{
+				HasUTXO:           true,
+				MissingSignatures: []string{"keyhash"},
+				Next:              privatebtc.PSBTRoleSigner,
+			}},
+			EstimatedVSize:   141,
+			EstimatedFeeRate: 10,
+			Fee:              1410 * privatebtc.Satoshi,
+			Next:             privatebtc.PSBTRoleSigner,
+		}, analysis)
+	})
+
+	t.Run("RPCError", func(t *testing.T) {
+		t.Parallel()
+
+		c, _ := newFakeNodeRPCClient(t, func(method string, _ []json.RawMessage) (any, *jsonrpc.Error) {
+			if method == "ping" {
+				return nil, nil
+			}
+
+			return nil, &jsonrpc.Error{Code: -4, Message: "Wallet already exists."}
+		})
+
+		err := c.CreateWallet(context.Background(), "wallet")
+
+		var rpcErr *jsonrpc.Error
+
+		require.ErrorAs(t, err, &rpcErr)
+		require.Equal(t, -4, rpcErr.Code)
+	})
+
+	t.Run("WalletWarning", func(t *testing.T) {
+		t.Parallel()
+
+		c, _ := newFakeNodeRPCClient(t, func(string, []json.RawMessage) (any, *jsonrpc.Error) {
+			return map[string]any{"name": "wallet", "warnings": []string{"warning"}}, nil
+		})
+
+		err := c.LoadWallet(context.Background(), "wallet")
+		require.ErrorIs(t, err, jsonrpc.WalletWarningError("warning"))
+	})
+
+	t.Run("ImportDescriptors", func(t *testing.T) {
+		t.Parallel()
+
+		var params []json.RawMessage
+
+		c, _ := newFakeNodeRPCClient(t, func(_ string, p []json.RawMessage) (any, *jsonrpc.Error) {
+			params = p
+
+			return json.RawMessage(`[{"success":true},` +
+				`{"success":false,"error":{"code":-5,"message":"Invalid descriptor"}}]`), nil
+		})
+
+		err := c.ImportDescriptors(context.Background(), []privatebtc.ImportDescriptorRequest{
+			{Desc: "wsh(multi)#chk", Active: true},
+			{Desc: "addr(invalid)", Label: "label", Timestamp: time.Unix(1700000000, 0)},
+		})
+		require.ErrorIs(t, err, privatebtc.ErrImportDescriptorFailed)
+		require.ErrorContains(t, err, "descriptor 1: Invalid descriptor")
+
+		require.Len(t, params, 1)
+		require.JSONEq(t, `[`+
+			`{"desc":"wsh(multi)#chk","active":true,"internal":false,"timestamp":"now"},`+
+			`{"desc":"addr(invalid)","active":false,"internal":false,"label":"label","timestamp":1700000000}`+
+			`]`, string(params[0]))
+	})
+
+	t.Run("Wallet", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			mu    sync.Mutex
+			paths = map[string]string{}
+		)
+
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req rpcRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+
+				return
+			}
+
+			mu.Lock()
+			paths[req.Method] = r.URL.Path
+			mu.Unlock()
+
+			var result any
+
+			switch req.Method {
+			case "listwallets":
+				result = []string{"hot", "cold storage"}
+			case "getbalances":
+				result = json.RawMessage(`{"mine":{"trusted":1,"untrusted_pending":0,"immature":0}}`)
+			}
+
+			_ = json.NewEncoder(w).Encode(rpcResponse{ID: req.ID, Result: result})
+		}))
+
+		t.Cleanup(srv.Close)
+
+		_, port, err := net.SplitHostPort(srv.Listener.Addr().String())
+		require.NoError(t, err)
+
+		c, err := jsonrpc.RPCClientFactory{NoPing: true}.NewRPCClient(context.Background(), port, "user", "pass")
+		require.NoError(t, err)
+
+		wallets, err := c.ListWallets(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, []string{"hot", "cold storage"}, wallets)
+
+		cold, err := c.Wallet("cold storage")
+		require.NoError(t, err)
+
+		balance, err := cold.GetBalance(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, privatebtc.BTC, balance.Trusted)
+
+		require.NoError(t, cold.UnloadWallet(context.Background(), "hot"))
+
+		require.Equal(t, map[string]string{
+			"listwallets":  "/",
+			"getbalances":  "/wallet/cold storage",
+			"unloadwallet": "/wallet/cold storage",
+		}, paths)
+	})
+
+	t.Run("Unauthorized", func(t *testing.T) {
+		t.Parallel()
+
+		port, _ := newFakeNode(t, func(string, []json.RawMessage) (any, *jsonrpc.Error) {
+			return nil, nil
+		})
+
+		_, err := jsonrpc.RPCClientFactory{}.NewRPCClient(context.Background(), port, "user", "wrong")
+		require.ErrorIs(t, err, jsonrpc.ErrUnexpectedStatusCode)
+	})
+
+	t.Run("Deadline", func(t *testing.T) {
+		t.Parallel()
+
+		stalled := make(chan struct{})
+
+		srv := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+			<-stalled
+		}))
+
+		t.Cleanup(func() {
+			close(stalled)
+			srv.Close()
+		})
+
+		_, port, err := net.SplitHostPort(srv.Listener.Addr().String())
+		require.NoError(t, err)
+
+		c, err := jsonrpc.RPCClientFactory{NoPing: true}.NewRPCClient(context.Background(), port, "user", "pass")
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+
+		start := time.Now()
+
+		_, err = c.GetBlockCount(ctx)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+
+		require.Less(t, time.Since(start), time.Second)
+
+		// the ping of a node which is still warming up is bounded as well.
+		start = time.Now()
+
+		_, err = jsonrpc.RPCClientFactory{}.NewRPCClient(ctx, port, "user", "pass")
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+
+		require.Less(t, time.Since(start), time.Second)
+	})
+}
+
+func TestRPCClientBatch(t *testing.T) {
+	t.Parallel()
+
+	handler := func(method string, params []json.RawMessage) (any, *jsonrpc.Error) {
+		if method != "getrawtransaction" && method != "getmempoolentry" {
+			return nil, nil
+		}
+
+		var txHash string
+		if err := json.Unmarshal(params[0], &txHash); err != nil {
+			return nil, &jsonrpc.Error{Code: -8, Message: err.Error()}
+		}
+
+		if txHash == "invalid" {
+			return nil, &jsonrpc.Error{Code: -8, Message: "parameter 1 must be hexadecimal string"}
+		}
+
+		if txHash == "mined" && method == "getmempoolentry" {
+			return nil, &jsonrpc.Error{Code: -5, Message: "Transaction not in mempool"}
+		}
+
+		if txHash == "mined" {
+			return nil, &jsonrpc.Error{
+				Code:    -5,
+				Message: "No such mempool or blockchain transaction. Use gettransaction for wallet transactions.",
+			}
+		}
+
+		if method == "getmempoolentry" {
+			return json.RawMessage(`{"vsize":141,"time":1700000000,` +
+				`"ancestorcount":1,"ancestorsize":141,"descendantcount":2,"descendantsize":251,` +
+				`"fees":{"base":0.00001410,"ancestor":0.00001410,"descendant":0.00002510},` +
+				`"bip125-replaceable":true}`), nil
+		}
+
+		return json.RawMessage(`{"txid":"` + txHash + `","vout":[` +
+			`{"value":0.50000000,"n":0,"scriptPubKey":{"address":"addr_` + txHash + `"}}]}`), nil
+	}
+
+	t.Run("GetTransactionsOutputs", func(t *testing.T) {
+		t.Parallel()
+
+		c, requests := newFakeNodeRPCClient(t, handler)
+
+		outputs, err := c.GetTransactionsOutputs(context.Background(), []string{"a", "b", "c"})
+		require.NoError(t, err)
+
+		require.Equal(t, int64(1), requests.Load())
+
+		for i, txHash := range []string{"a", "b", "c"} {
+			require.Equal(t, []privatebtc.MempoolTransactionOutput{{
+				Address: "addr_" + txHash,
+				Value:   privatebtc.BTC / 2,
+			}}, outputs[i])
+		}
+
+		outputs, err = c.GetTransactionsOutputs(context.Background(), []string{"a", "mined"})
+		require.NoError(t, err)
+		require.NotNil(t, outputs[0])
+		require.Nil(t, outputs[1])
+
+		_, err = c.GetTransactionOutputs(context.Background(), "mined")
+		require.ErrorIs(t, err, privatebtc.ErrTxNotFoundInMempool)
+	})
+
+	t.Run("GetMempoolEntries", func(t *testing.T) {
+		t.Parallel()
+
+		c, requests := newFakeNodeRPCClient(t, handler)
+
+		entries, err := c.GetMempoolEntries(context.Background(), []string{"a", "b"})
+		require.NoError(t, err)
+
+		require.Equal(t, int64(1), requests.Load())
+
+		for _, entry := range entries {
+			require.Equal(t, &privatebtc.MempoolEntry{
+				VSize:             141,
+				Fee:               1410,
+				Time:              time.Unix(1700000000, 0),
+				AncestorCount:     1,
+				AncestorSize:      141,
+				AncestorFees:      1410,
+				DescendantCount:   2,
+				DescendantSize:    251,
+				DescendantFees:    2510,
+				BIP125Replaceable: true,
+			}, entry)
+		}
+
+		_, err = c.GetMempoolEntries(context.Background(), []string{"a", "invalid"})
+		require.Error(t, err)
+
+		entries, err = c.GetMempoolEntries(context.Background(), []string{"a", "mined"})
+		require.NoError(t, err)
+		require.NotNil(t, entries[0])
+		require.Nil(t, entries[1])
+
+		_, err = c.GetMempoolEntry(context.Background(), "mined")
+		require.ErrorIs(t, err, privatebtc.ErrTxNotFoundInMempool)
+	})
+
+	t.Run("CallError", func(t *testing.T) {
+		t.Parallel()
+
+		c, requests := newFakeNodeRPCClient(t, handler)
+
+		var (
+			count int
+			tx    json.RawMessage
+		)
+
+		calls := []*jsonrpc.Call{
+			jsonrpc.NewCall(&count, "getblockcount"),
+			jsonrpc.NewCall(&tx, "getrawtransaction", "invalid", true),
+		}
+
+		require.NoError(t, c.Batch(context.Background(), calls...))
+		require.Equal(t, int64(1), requests.Load())
+
+		require.NoError(t, calls[0].Err)
+
+		var rpcErr *jsonrpc.Error
+
+		require.ErrorAs(t, calls[1].Err, &rpcErr)
+		require.Equal(t, -8, rpcErr.Code)
+
+		_, err := c.GetTransactionsOutputs(context.Background(), []string{"a", "invalid"})
+		require.ErrorAs(t, err, &rpcErr)
+	})
+}
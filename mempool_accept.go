@@ -0,0 +1,203 @@
+package privatebtc
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+	"golang.org/x/sync/errgroup"
+)
+
+// RejectReason is the reason a node rejects a transaction from its mempool.
+// Script verification failures are followed by their details,
+// e.g. "mandatory-script-verify-flag-failed (Signature must be zero for failed CHECK(MULTI)SIG operation)".
+type RejectReason string
+
+// The most common reject reasons.
+const (
+	// RejectReasonMissingInputs is returned by testmempoolaccept when the transaction
+	// spends outputs which do not exist or are already spent.
+	RejectReasonMissingInputs RejectReason = "missing-inputs"
+	// RejectReasonInputsMissingOrSpent is returned by submitpackage when the transaction
+	// spends outputs which do not exist or are already spent.
+	RejectReasonInputsMissingOrSpent RejectReason = "bad-txns-inputs-missingorspent"
+	// RejectReasonMempoolConflict is returned when the transaction spends the outputs
+	// of a mempool transaction which it cannot replace.
+	RejectReasonMempoolConflict RejectReason = "txn-mempool-conflict"
+	// RejectReasonAlreadyInMempool is returned when the transaction is already in the mempool.
+	RejectReasonAlreadyInMempool RejectReason = "txn-already-in-mempool"
+	// RejectReasonAlreadyKnown is returned when the transaction is already confirmed.
+	RejectReasonAlreadyKnown RejectReason = "txn-already-known"
+	// RejectReasonInsufficientFee is returned when a replacement transaction does not
+	// pay enough fees to replace the transactions it conflicts with.
+	RejectReasonInsufficientFee RejectReason = "insufficient fee"
+	// RejectReasonMinRelayFeeNotMet is returned when the transaction fee rate is below
+	// the node minimum relay fee rate.
+	RejectReasonMinRelayFeeNotMet RejectReason = "min relay fee not met"
+	// RejectReasonMempoolMinFeeNotMet is returned when the transaction fee rate is below
+	// the fee rate required by the node full mempool.
+	RejectReasonMempoolMinFeeNotMet RejectReason = "mempool min fee not met"
+	// RejectReasonNonFinal is returned when the transaction lock time is not reached.
+	RejectReasonNonFinal RejectReason = "non-final"
+	// RejectReasonNonBIP68Final is returned when the relative lock time of an input is not reached.
+	RejectReasonNonBIP68Final RejectReason = "non-BIP68-final"
+	// RejectReasonTooLongMempoolChain is returned when the transaction exceeds the
+	// mempool ancestor or descendant limits.
+	RejectReasonTooLongMempoolChain RejectReason = "too-long-mempool-chain"
+	// RejectReasonDust is returned when an output amount is below the dust threshold.
+	RejectReasonDust RejectReason = "dust"
+	// RejectReasonPrematureCoinbaseSpend is returned when the transaction spends
+	// a coinbase output with less than 100 confirmations.
+	RejectReasonPrematureCoinbaseSpend RejectReason = "bad-txns-premature-spend-of-coinbase"
+)
+
+// MempoolAcceptResult is the result of testing whether a transaction would be accepted
+// into the mempool of a node, without broadcasting it.
+type MempoolAcceptResult struct {
+	TxID  string
+	WTxID string
+	// Allowed reports whether the transaction would be accepted.
+	Allowed bool
+	// VSize is the virtual size of the transaction, set only if allowed.
+	VSize int
+	// Fee is the transaction fee, set only if allowed.
+	Fee Amount
+	// RejectReason is the reason the transaction would be rejected, empty if allowed.
+	RejectReason RejectReason
+	// PackageError is the reason the whole package would be rejected, empty for a
+	// single transaction. The transactions of a rejected package may be neither
+	// allowed nor have a reject reason, as their validation is skipped.
+	PackageError string
+}
+
+// MempoolAcceptVerdict is the outcome of testing the mempool acceptance of a transaction,
+// the details which the nodes of a network are expected to agree on.
+type MempoolAcceptVerdict struct {
+	Allowed      bool
+	RejectReason RejectReason
+	PackageError string
+}
+
+// Verdict returns the outcome of the test.
+func (r MempoolAcceptResult) Verdict() MempoolAcceptVerdict {
+	return MempoolAcceptVerdict{
+		Allowed:      r.Allowed,
+		RejectReason: r.RejectReason,
+		PackageError: r.PackageError,
+	}
+}
+
+// PackageSubmitResult is the result of submitting a package of transactions.
+type PackageSubmitResult struct {
+	// Message is "success" if every transaction was accepted, or already in the mempool.
+	// Requires Bitcoin Core v27 or newer.
+	Message string
+	// Transactions are the results of the package transactions, keyed by wtxid.
+	Transactions map[string]PackageTransactionResult
+	// ReplacedTransactions are the hashes of the transactions evicted from the mempool
+	// by the package.
+	ReplacedTransactions []string
+}
+
+// PackageTransactionResult is the result of submitting a transaction as part of a package.
+type PackageTransactionResult struct {
+	TxID string
+	// OtherWTxID is the wtxid of the transaction with the same txid already in the mempool,
+	// set if the transaction was not submitted because of it.
+	OtherWTxID string
+	VSize      int
+	Fee        Amount
+	// Error is the reason the transaction was rejected, empty if accepted.
+	// Requires Bitcoin Core v27 or newer.
+	Error RejectReason
+}
+
+// NetworkMempoolAccept are the results of testing the mempool acceptance of
+// the same transactions on every node, keyed by node ID.
+type NetworkMempoolAccept map[int][]MempoolAcceptResult
+
+// MempoolAcceptDisagreement is a transaction which the nodes of a network do not
+// agree on whether, or why, it would be rejected from their mempool.
+type MempoolAcceptDisagreement struct {
+	// Index is the index of the transaction in the tested transactions.
+	Index int
+	TxID  string
+	// Verdicts are the IDs of the nodes, in ascending order, grouped by their verdict.
+	Verdicts map[MempoolAcceptVerdict][]int
+}
+
+// Disagreements returns the transactions the nodes disagree on, in the order they were tested.
+func (a NetworkMempoolAccept) Disagreements() []MempoolAcceptDisagreement {
+	nodeIDs := maps.Keys(a)
+
+	slices.Sort(nodeIDs)
+
+	if len(nodeIDs) == 0 {
+		return nil
+	}
+
+	var disagreements []MempoolAcceptDisagreement
+
+	for i, result := range a[nodeIDs[0]] {
+		verdicts := map[MempoolAcceptVerdict][]int{}
+
+		for _, id := range nodeIDs {
+			var verdict MempoolAcceptVerdict
+
+			if i < len(a[id]) {
+				verdict = a[id][i].Verdict()
+			}
+
+			verdicts[verdict] = append(verdicts[verdict], id)
+		}
+
+		if len(verdicts) > 1 {
+			disagreements = append(disagreements, MempoolAcceptDisagreement{
+				Index:    i,
+				TxID:     result.TxID,
+				Verdicts: verdicts,
+			})
+		}
+	}
+
+	return disagreements
+}
+
+// TestMempoolAccept tests, on every node, whether the given hex encoded signed transactions,
+// a single transaction or a package of related transactions, would be accepted into
+// the node mempool, without broadcasting them.
+// The disagreements between nodes running different Bitcoin Core versions, or
+// with different mempools, are returned by NetworkMempoolAccept.Disagreements.
+func (nodes Nodes) TestMempoolAccept(ctx context.Context, txHexes []string) (NetworkMempoolAccept, error) {
+	results := make(NetworkMempoolAccept, len(nodes))
+
+	var mutex sync.Mutex
+
+	eg, egCtx := errgroup.WithContext(ctx)
+
+	for i := range nodes {
+		node := nodes[i]
+
+		eg.Go(func() error {
+			nodeResults, err := node.RPCClient().TestMempoolAccept(egCtx, txHexes)
+			if err != nil {
+				return fmt.Errorf("test mempool accept for node %d: %w", node.ID(), err)
+			}
+
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			results[node.ID()] = nodeResults
+
+			return nil
+		})
+	}
+
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
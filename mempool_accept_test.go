@@ -0,0 +1,115 @@
+package privatebtc_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/adrianbrad/privatebtc"
+	"github.com/adrianbrad/privatebtc/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var errTestMempoolAccept = errors.New("test mempool accept error")
+
+// nolint: funlen
+func TestNodesTestMempoolAccept(t *testing.T) {
+	t.Parallel()
+
+	var (
+		parentAllowed = privatebtc.MempoolAcceptResult{
+			TxID:    "parent",
+			Allowed: true,
+			VSize:   141,
+			Fee:     1410,
+		}
+		childAllowed = privatebtc.MempoolAcceptResult{
+			TxID:    "child",
+			Allowed: true,
+			VSize:   110,
+			Fee:     1100,
+		}
+		childNonFinal = privatebtc.MempoolAcceptResult{
+			TxID:         "child",
+			RejectReason: privatebtc.RejectReasonNonFinal,
+		}
+	)
+
+	tests := map[string]struct {
+		results               map[int][]privatebtc.MempoolAcceptResult
+		failingNode           int
+		expectedError         error
+		expectedDisagreements []privatebtc.MempoolAcceptDisagreement
+	}{
+		"Agreement": {
+			results: map[int][]privatebtc.MempoolAcceptResult{
+				0: {parentAllowed, childNonFinal},
+				1: {parentAllowed, childNonFinal},
+				2: {parentAllowed, childNonFinal},
+			},
+			failingNode: -1,
+		},
+		"Disagreement": {
+			results: map[int][]privatebtc.MempoolAcceptResult{
+				0: {parentAllowed, childAllowed},
+				1: {parentAllowed, childNonFinal},
+				2: {parentAllowed, childAllowed},
+			},
+			failingNode: -1,
+			expectedDisagreements: []privatebtc.MempoolAcceptDisagreement{{
+				Index: 1,
+				TxID:  "child",
+				Verdicts: map[privatebtc.MempoolAcceptVerdict][]int{
+					{Allowed: true}: {0, 2},
+					{RejectReason: privatebtc.RejectReasonNonFinal}: {1},
+				},
+			}},
+		},
+		"NodeError": {
+			failingNode:   1,
+			expectedError: errTestMempoolAccept,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := require.New(t)
+
+			mocks := newMockNodes(func(id int) *mock.RPCClient {
+				return &mock.RPCClient{
+					TestMempoolAcceptFunc: func(
+						_ context.Context,
+						txHexes []string,
+					) ([]privatebtc.MempoolAcceptResult, error) {
+						req.Equal([]string{"parenthex", "childhex"}, txHexes)
+
+						if id == test.failingNode {
+							return nil, errTestMempoolAccept
+						}
+
+						return test.results[id], nil
+					},
+				}
+			})
+
+			pn, err := privatebtc.NewPrivateNetwork(mocks.nodeService(3), mocks.rpcClientFactory(), 3)
+			req.NoError(err)
+
+			req.NoError(pn.Start(context.Background()))
+
+			results, err := pn.Nodes().TestMempoolAccept(context.Background(), []string{"parenthex", "childhex"})
+			req.ErrorIs(err, test.expectedError)
+
+			if test.expectedError != nil {
+				return
+			}
+
+			req.Equal(privatebtc.NetworkMempoolAccept(test.results), results)
+			req.Equal(test.expectedDisagreements, results.Disagreements())
+		})
+	}
+}
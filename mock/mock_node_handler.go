@@ -4,7 +4,9 @@
 package mock
 
 import (
+	"context"
 	"github.com/adrianbrad/privatebtc"
+	"io"
 	"sync"
 )
 
@@ -21,6 +23,9 @@ var _ privatebtc.NodeHandler = &NodeHandler{}
 //			CloseFunc: func() error {
 //				panic("mock out the Close method")
 //			},
+//			ExportDatadirFunc: func(ctx context.Context) (io.ReadCloser, error) {
+//				panic("mock out the ExportDatadir method")
+//			},
 //			HostRPCPortFunc: func() string {
 //				panic("mock out the HostRPCPort method")
 //			},
@@ -40,6 +45,9 @@ type NodeHandler struct {
 	// CloseFunc mocks the Close method.
 	CloseFunc func() error
 
+	// ExportDatadirFunc mocks the ExportDatadir method.
+	ExportDatadirFunc func(ctx context.Context) (io.ReadCloser, error)
+
 	// HostRPCPortFunc mocks the HostRPCPort method.
 	HostRPCPortFunc func() string
 
@@ -54,6 +62,11 @@ type NodeHandler struct {
 		// Close holds details about calls to the Close method.
 		Close []struct {
 		}
+		// ExportDatadir holds details about calls to the ExportDatadir method.
+		ExportDatadir []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
 		// HostRPCPort holds details about calls to the HostRPCPort method.
 		HostRPCPort []struct {
 		}
@@ -64,10 +77,11 @@ type NodeHandler struct {
 		Name []struct {
 		}
 	}
-	lockClose       sync.RWMutex
-	lockHostRPCPort sync.RWMutex
-	lockInternalIP  sync.RWMutex
-	lockName        sync.RWMutex
+	lockClose         sync.RWMutex
+	lockExportDatadir sync.RWMutex
+	lockHostRPCPort   sync.RWMutex
+	lockInternalIP    sync.RWMutex
+	lockName          sync.RWMutex
 }
 
 // Close calls CloseFunc.
@@ -97,6 +111,38 @@ func (mock *NodeHandler) CloseCalls() []struct {
 	return calls
 }
 
+// ExportDatadir calls ExportDatadirFunc.
+func (mock *NodeHandler) ExportDatadir(ctx context.Context) (io.ReadCloser, error) {
+	if mock.ExportDatadirFunc == nil {
+		panic("NodeHandler.ExportDatadirFunc: method is nil but NodeHandler.ExportDatadir was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockExportDatadir.Lock()
+	mock.calls.ExportDatadir = append(mock.calls.ExportDatadir, callInfo)
+	mock.lockExportDatadir.Unlock()
+	return mock.ExportDatadirFunc(ctx)
+}
+
+// ExportDatadirCalls gets all the calls that were made to ExportDatadir.
+// Check the length with:
+//
+//	len(mockedNodeHandler.ExportDatadirCalls())
+func (mock *NodeHandler) ExportDatadirCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockExportDatadir.RLock()
+	calls = mock.calls.ExportDatadir
+	mock.lockExportDatadir.RUnlock()
+	return calls
+}
+
 // HostRPCPort calls HostRPCPortFunc.
 func (mock *NodeHandler) HostRPCPort() string {
 	if mock.HostRPCPortFunc == nil {
@@ -22,6 +22,12 @@ var _ privatebtc.NodeService = &NodeService{}
 //			CreateNodesFunc: func(ctx context.Context, nodeRequests []privatebtc.CreateNodeRequest) ([]privatebtc.NodeHandler, error) {
 //				panic("mock out the CreateNodes method")
 //			},
+//			ListNodesFunc: func(ctx context.Context, networkID string) (map[int]privatebtc.NodeHandler, error) {
+//				panic("mock out the ListNodes method")
+//			},
+//			RemoveNetworkFunc: func(ctx context.Context, networkID string) error {
+//				panic("mock out the RemoveNetwork method")
+//			},
 //		}
 //
 //		// use mockedNodeService in code that requires privatebtc.NodeService
@@ -32,6 +38,12 @@ type NodeService struct {
 	// CreateNodesFunc mocks the CreateNodes method.
 	CreateNodesFunc func(ctx context.Context, nodeRequests []privatebtc.CreateNodeRequest) ([]privatebtc.NodeHandler, error)
 
+	// ListNodesFunc mocks the ListNodes method.
+	ListNodesFunc func(ctx context.Context, networkID string) (map[int]privatebtc.NodeHandler, error)
+
+	// RemoveNetworkFunc mocks the RemoveNetwork method.
+	RemoveNetworkFunc func(ctx context.Context, networkID string) error
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// CreateNodes holds details about calls to the CreateNodes method.
@@ -41,8 +53,24 @@ type NodeService struct {
 			// NodeRequests is the nodeRequests argument value.
 			NodeRequests []privatebtc.CreateNodeRequest
 		}
+		// ListNodes holds details about calls to the ListNodes method.
+		ListNodes []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// NetworkID is the networkID argument value.
+			NetworkID string
+		}
+		// RemoveNetwork holds details about calls to the RemoveNetwork method.
+		RemoveNetwork []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// NetworkID is the networkID argument value.
+			NetworkID string
+		}
 	}
-	lockCreateNodes sync.RWMutex
+	lockCreateNodes   sync.RWMutex
+	lockListNodes     sync.RWMutex
+	lockRemoveNetwork sync.RWMutex
 }
 
 // CreateNodes calls CreateNodesFunc.
@@ -80,3 +108,75 @@ func (mock *NodeService) CreateNodesCalls() []struct {
 	mock.lockCreateNodes.RUnlock()
 	return calls
 }
+
+// ListNodes calls ListNodesFunc.
+func (mock *NodeService) ListNodes(ctx context.Context, networkID string) (map[int]privatebtc.NodeHandler, error) {
+	if mock.ListNodesFunc == nil {
+		panic("NodeService.ListNodesFunc: method is nil but NodeService.ListNodes was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		NetworkID string
+	}{
+		Ctx:       ctx,
+		NetworkID: networkID,
+	}
+	mock.lockListNodes.Lock()
+	mock.calls.ListNodes = append(mock.calls.ListNodes, callInfo)
+	mock.lockListNodes.Unlock()
+	return mock.ListNodesFunc(ctx, networkID)
+}
+
+// ListNodesCalls gets all the calls that were made to ListNodes.
+// Check the length with:
+//
+//	len(mockedNodeService.ListNodesCalls())
+func (mock *NodeService) ListNodesCalls() []struct {
+	Ctx       context.Context
+	NetworkID string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		NetworkID string
+	}
+	mock.lockListNodes.RLock()
+	calls = mock.calls.ListNodes
+	mock.lockListNodes.RUnlock()
+	return calls
+}
+
+// RemoveNetwork calls RemoveNetworkFunc.
+func (mock *NodeService) RemoveNetwork(ctx context.Context, networkID string) error {
+	if mock.RemoveNetworkFunc == nil {
+		panic("NodeService.RemoveNetworkFunc: method is nil but NodeService.RemoveNetwork was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		NetworkID string
+	}{
+		Ctx:       ctx,
+		NetworkID: networkID,
+	}
+	mock.lockRemoveNetwork.Lock()
+	mock.calls.RemoveNetwork = append(mock.calls.RemoveNetwork, callInfo)
+	mock.lockRemoveNetwork.Unlock()
+	return mock.RemoveNetworkFunc(ctx, networkID)
+}
+
+// RemoveNetworkCalls gets all the calls that were made to RemoveNetwork.
+// Check the length with:
+//
+//	len(mockedNodeService.RemoveNetworkCalls())
+func (mock *NodeService) RemoveNetworkCalls() []struct {
+	Ctx       context.Context
+	NetworkID string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		NetworkID string
+	}
+	mock.lockRemoveNetwork.RLock()
+	calls = mock.calls.RemoveNetwork
+	mock.lockRemoveNetwork.RUnlock()
+	return calls
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"github.com/adrianbrad/privatebtc"
 	"sync"
+	"time"
 )
 
 // Ensure, that RPCClient does implement privatebtc.RPCClient.
@@ -22,30 +23,81 @@ var _ privatebtc.RPCClient = &RPCClient{}
 //			AddPeerFunc: func(ctx context.Context, peer privatebtc.Node) error {
 //				panic("mock out the AddPeer method")
 //			},
+//			AnalyzePSBTFunc: func(ctx context.Context, psbt string) (*privatebtc.PSBTAnalysis, error) {
+//				panic("mock out the AnalyzePSBT method")
+//			},
+//			BanPeerFunc: func(ctx context.Context, peer privatebtc.Node, duration time.Duration) error {
+//				panic("mock out the BanPeer method")
+//			},
+//			BumpFeeFunc: func(ctx context.Context, txHash string, feeRate privatebtc.FeeRate) (*privatebtc.BumpedTransaction, error) {
+//				panic("mock out the BumpFee method")
+//			},
+//			CombinePSBTFunc: func(ctx context.Context, psbts []string) (string, error) {
+//				panic("mock out the CombinePSBT method")
+//			},
+//			CreateMultisigFunc: func(ctx context.Context, nRequired int, pubKeys []string) (*privatebtc.Multisig, error) {
+//				panic("mock out the CreateMultisig method")
+//			},
 //			CreateWalletFunc: func(ctx context.Context, walletName string) error {
 //				panic("mock out the CreateWallet method")
 //			},
+//			CreateWatchOnlyWalletFunc: func(ctx context.Context, walletName string) error {
+//				panic("mock out the CreateWatchOnlyWallet method")
+//			},
+//			DeriveAddressFunc: func(ctx context.Context, descriptor string) (string, error) {
+//				panic("mock out the DeriveAddress method")
+//			},
+//			FinalizePSBTFunc: func(ctx context.Context, psbt string) (*privatebtc.FinalizedPSBT, error) {
+//				panic("mock out the FinalizePSBT method")
+//			},
+//			FlushChainStateFunc: func(ctx context.Context) error {
+//				panic("mock out the FlushChainState method")
+//			},
 //			GenerateToAddressFunc: func(ctx context.Context, numBlocks int64, address string) ([]string, error) {
 //				panic("mock out the GenerateToAddress method")
 //			},
+//			GetAddressInfoFunc: func(ctx context.Context, address string) (*privatebtc.AddressInfo, error) {
+//				panic("mock out the GetAddressInfo method")
+//			},
 //			GetBalanceFunc: func(ctx context.Context) (privatebtc.Balance, error) {
 //				panic("mock out the GetBalance method")
 //			},
 //			GetBestBlockHashFunc: func(ctx context.Context) (string, error) {
 //				panic("mock out the GetBestBlockHash method")
 //			},
+//			GetBlockFunc: func(ctx context.Context, blockHash string) (*privatebtc.Block, error) {
+//				panic("mock out the GetBlock method")
+//			},
 //			GetBlockCountFunc: func(ctx context.Context) (int, error) {
 //				panic("mock out the GetBlockCount method")
 //			},
-//			GetCoinbaseValueFunc: func(ctx context.Context) (int64, error) {
+//			GetBlockHashFunc: func(ctx context.Context, height int) (string, error) {
+//				panic("mock out the GetBlockHash method")
+//			},
+//			GetBlockHeaderFunc: func(ctx context.Context, blockHash string) (*privatebtc.BlockHeader, error) {
+//				panic("mock out the GetBlockHeader method")
+//			},
+//			GetChainTipsFunc: func(ctx context.Context) ([]privatebtc.ChainTip, error) {
+//				panic("mock out the GetChainTips method")
+//			},
+//			GetCoinbaseValueFunc: func(ctx context.Context) (privatebtc.Amount, error) {
 //				panic("mock out the GetCoinbaseValue method")
 //			},
 //			GetConnectionCountFunc: func(ctx context.Context) (int, error) {
 //				panic("mock out the GetConnectionCount method")
 //			},
+//			GetDescriptorInfoFunc: func(ctx context.Context, descriptor string) (*privatebtc.DescriptorInfo, error) {
+//				panic("mock out the GetDescriptorInfo method")
+//			},
+//			GetMempoolEntryFunc: func(ctx context.Context, txHash string) (*privatebtc.MempoolEntry, error) {
+//				panic("mock out the GetMempoolEntry method")
+//			},
 //			GetNewAddressFunc: func(ctx context.Context, label string) (string, error) {
 //				panic("mock out the GetNewAddress method")
 //			},
+//			GetPeerInfoFunc: func(ctx context.Context) ([]privatebtc.Peer, error) {
+//				panic("mock out the GetPeerInfo method")
+//			},
 //			GetRawMempoolFunc: func(ctx context.Context) ([]string, error) {
 //				panic("mock out the GetRawMempool method")
 //			},
@@ -55,18 +107,75 @@ var _ privatebtc.RPCClient = &RPCClient{}
 //			GetTransactionOutputsFunc: func(ctx context.Context, txHash string) ([]privatebtc.MempoolTransactionOutput, error) {
 //				panic("mock out the GetTransactionOutputs method")
 //			},
+//			ImportDescriptorsFunc: func(ctx context.Context, requests []privatebtc.ImportDescriptorRequest) error {
+//				panic("mock out the ImportDescriptors method")
+//			},
 //			ListAddressesFunc: func(ctx context.Context) ([]string, error) {
 //				panic("mock out the ListAddresses method")
 //			},
+//			ListDescriptorsFunc: func(ctx context.Context, private bool) ([]privatebtc.Descriptor, error) {
+//				panic("mock out the ListDescriptors method")
+//			},
+//			ListLockUnspentFunc: func(ctx context.Context) ([]privatebtc.TransactionVin, error) {
+//				panic("mock out the ListLockUnspent method")
+//			},
+//			ListUnspentFunc: func(ctx context.Context, filter privatebtc.UTXOFilter) ([]privatebtc.UTXO, error) {
+//				panic("mock out the ListUnspent method")
+//			},
+//			ListWalletsFunc: func(ctx context.Context) ([]string, error) {
+//				panic("mock out the ListWallets method")
+//			},
+//			LoadWalletFunc: func(ctx context.Context, walletName string) error {
+//				panic("mock out the LoadWallet method")
+//			},
+//			LockUnspentFunc: func(ctx context.Context, outpoints []privatebtc.TransactionVin) error {
+//				panic("mock out the LockUnspent method")
+//			},
+//			PSBTBumpFeeFunc: func(ctx context.Context, txHash string, feeRate privatebtc.FeeRate) (*privatebtc.BumpedTransaction, error) {
+//				panic("mock out the PSBTBumpFee method")
+//			},
 //			RemovePeerFunc: func(ctx context.Context, peer privatebtc.Node) error {
 //				panic("mock out the RemovePeer method")
 //			},
-//			SendCustomTransactionFunc: func(ctx context.Context, inputs []privatebtc.TransactionVin, amounts map[string]float64) (string, error) {
+//			SendCustomTransactionFunc: func(ctx context.Context, inputs []privatebtc.TransactionVin, amounts map[string]privatebtc.Amount, lockTime uint32) (string, error) {
 //				panic("mock out the SendCustomTransaction method")
 //			},
-//			SendToAddressFunc: func(ctx context.Context, address string, amount float64) (string, error) {
+//			SendRawTransactionFunc: func(ctx context.Context, txHex string) (string, error) {
+//				panic("mock out the SendRawTransaction method")
+//			},
+//			SendToAddressFunc: func(ctx context.Context, address string, amount privatebtc.Amount, opts privatebtc.SendOptions) (string, error) {
 //				panic("mock out the SendToAddress method")
 //			},
+//			SetMockTimeFunc: func(ctx context.Context, t time.Time) error {
+//				panic("mock out the SetMockTime method")
+//			},
+//			SetNetworkActiveFunc: func(ctx context.Context, active bool) error {
+//				panic("mock out the SetNetworkActive method")
+//			},
+//			SubmitPackageFunc: func(ctx context.Context, txHexes []string) (*privatebtc.PackageSubmitResult, error) {
+//				panic("mock out the SubmitPackage method")
+//			},
+//			TestMempoolAcceptFunc: func(ctx context.Context, txHexes []string) ([]privatebtc.MempoolAcceptResult, error) {
+//				panic("mock out the TestMempoolAccept method")
+//			},
+//			UnbanPeerFunc: func(ctx context.Context, peer privatebtc.Node) error {
+//				panic("mock out the UnbanPeer method")
+//			},
+//			UnloadWalletFunc: func(ctx context.Context, walletName string) error {
+//				panic("mock out the UnloadWallet method")
+//			},
+//			UnlockUnspentFunc: func(ctx context.Context, outpoints []privatebtc.TransactionVin) error {
+//				panic("mock out the UnlockUnspent method")
+//			},
+//			WalletFunc: func(walletName string) (privatebtc.RPCClient, error) {
+//				panic("mock out the Wallet method")
+//			},
+//			WalletCreateFundedPSBTFunc: func(ctx context.Context, inputs []privatebtc.TransactionVin, amounts map[string]privatebtc.Amount, opts privatebtc.SendOptions) (*privatebtc.FundedPSBT, error) {
+//				panic("mock out the WalletCreateFundedPSBT method")
+//			},
+//			WalletProcessPSBTFunc: func(ctx context.Context, psbt string, sign bool) (*privatebtc.ProcessedPSBT, error) {
+//				panic("mock out the WalletProcessPSBT method")
+//			},
 //		}
 //
 //		// use mockedRPCClient in code that requires privatebtc.RPCClient
@@ -77,30 +186,81 @@ type RPCClient struct {
 	// AddPeerFunc mocks the AddPeer method.
 	AddPeerFunc func(ctx context.Context, peer privatebtc.Node) error
 
+	// AnalyzePSBTFunc mocks the AnalyzePSBT method.
+	AnalyzePSBTFunc func(ctx context.Context, psbt string) (*privatebtc.PSBTAnalysis, error)
+
+	// BanPeerFunc mocks the BanPeer method.
+	BanPeerFunc func(ctx context.Context, peer privatebtc.Node, duration time.Duration) error
+
+	// BumpFeeFunc mocks the BumpFee method.
+	BumpFeeFunc func(ctx context.Context, txHash string, feeRate privatebtc.FeeRate) (*privatebtc.BumpedTransaction, error)
+
+	// CombinePSBTFunc mocks the CombinePSBT method.
+	CombinePSBTFunc func(ctx context.Context, psbts []string) (string, error)
+
+	// CreateMultisigFunc mocks the CreateMultisig method.
+	CreateMultisigFunc func(ctx context.Context, nRequired int, pubKeys []string) (*privatebtc.Multisig, error)
+
 	// CreateWalletFunc mocks the CreateWallet method.
 	CreateWalletFunc func(ctx context.Context, walletName string) error
 
+	// CreateWatchOnlyWalletFunc mocks the CreateWatchOnlyWallet method.
+	CreateWatchOnlyWalletFunc func(ctx context.Context, walletName string) error
+
+	// DeriveAddressFunc mocks the DeriveAddress method.
+	DeriveAddressFunc func(ctx context.Context, descriptor string) (string, error)
+
+	// FinalizePSBTFunc mocks the FinalizePSBT method.
+	FinalizePSBTFunc func(ctx context.Context, psbt string) (*privatebtc.FinalizedPSBT, error)
+
+	// FlushChainStateFunc mocks the FlushChainState method.
+	FlushChainStateFunc func(ctx context.Context) error
+
 	// GenerateToAddressFunc mocks the GenerateToAddress method.
 	GenerateToAddressFunc func(ctx context.Context, numBlocks int64, address string) ([]string, error)
 
+	// GetAddressInfoFunc mocks the GetAddressInfo method.
+	GetAddressInfoFunc func(ctx context.Context, address string) (*privatebtc.AddressInfo, error)
+
 	// GetBalanceFunc mocks the GetBalance method.
 	GetBalanceFunc func(ctx context.Context) (privatebtc.Balance, error)
 
 	// GetBestBlockHashFunc mocks the GetBestBlockHash method.
 	GetBestBlockHashFunc func(ctx context.Context) (string, error)
 
+	// GetBlockFunc mocks the GetBlock method.
+	GetBlockFunc func(ctx context.Context, blockHash string) (*privatebtc.Block, error)
+
 	// GetBlockCountFunc mocks the GetBlockCount method.
 	GetBlockCountFunc func(ctx context.Context) (int, error)
 
+	// GetBlockHashFunc mocks the GetBlockHash method.
+	GetBlockHashFunc func(ctx context.Context, height int) (string, error)
+
+	// GetBlockHeaderFunc mocks the GetBlockHeader method.
+	GetBlockHeaderFunc func(ctx context.Context, blockHash string) (*privatebtc.BlockHeader, error)
+
+	// GetChainTipsFunc mocks the GetChainTips method.
+	GetChainTipsFunc func(ctx context.Context) ([]privatebtc.ChainTip, error)
+
 	// GetCoinbaseValueFunc mocks the GetCoinbaseValue method.
-	GetCoinbaseValueFunc func(ctx context.Context) (int64, error)
+	GetCoinbaseValueFunc func(ctx context.Context) (privatebtc.Amount, error)
 
 	// GetConnectionCountFunc mocks the GetConnectionCount method.
 	GetConnectionCountFunc func(ctx context.Context) (int, error)
 
+	// GetDescriptorInfoFunc mocks the GetDescriptorInfo method.
+	GetDescriptorInfoFunc func(ctx context.Context, descriptor string) (*privatebtc.DescriptorInfo, error)
+
+	// GetMempoolEntryFunc mocks the GetMempoolEntry method.
+	GetMempoolEntryFunc func(ctx context.Context, txHash string) (*privatebtc.MempoolEntry, error)
+
 	// GetNewAddressFunc mocks the GetNewAddress method.
 	GetNewAddressFunc func(ctx context.Context, label string) (string, error)
 
+	// GetPeerInfoFunc mocks the GetPeerInfo method.
+	GetPeerInfoFunc func(ctx context.Context) ([]privatebtc.Peer, error)
+
 	// GetRawMempoolFunc mocks the GetRawMempool method.
 	GetRawMempoolFunc func(ctx context.Context) ([]string, error)
 
@@ -110,17 +270,74 @@ type RPCClient struct {
 	// GetTransactionOutputsFunc mocks the GetTransactionOutputs method.
 	GetTransactionOutputsFunc func(ctx context.Context, txHash string) ([]privatebtc.MempoolTransactionOutput, error)
 
+	// ImportDescriptorsFunc mocks the ImportDescriptors method.
+	ImportDescriptorsFunc func(ctx context.Context, requests []privatebtc.ImportDescriptorRequest) error
+
 	// ListAddressesFunc mocks the ListAddresses method.
 	ListAddressesFunc func(ctx context.Context) ([]string, error)
 
+	// ListDescriptorsFunc mocks the ListDescriptors method.
+	ListDescriptorsFunc func(ctx context.Context, private bool) ([]privatebtc.Descriptor, error)
+
+	// ListLockUnspentFunc mocks the ListLockUnspent method.
+	ListLockUnspentFunc func(ctx context.Context) ([]privatebtc.TransactionVin, error)
+
+	// ListUnspentFunc mocks the ListUnspent method.
+	ListUnspentFunc func(ctx context.Context, filter privatebtc.UTXOFilter) ([]privatebtc.UTXO, error)
+
+	// ListWalletsFunc mocks the ListWallets method.
+	ListWalletsFunc func(ctx context.Context) ([]string, error)
+
+	// LoadWalletFunc mocks the LoadWallet method.
+	LoadWalletFunc func(ctx context.Context, walletName string) error
+
+	// LockUnspentFunc mocks the LockUnspent method.
+	LockUnspentFunc func(ctx context.Context, outpoints []privatebtc.TransactionVin) error
+
+	// PSBTBumpFeeFunc mocks the PSBTBumpFee method.
+	PSBTBumpFeeFunc func(ctx context.Context, txHash string, feeRate privatebtc.FeeRate) (*privatebtc.BumpedTransaction, error)
+
 	// RemovePeerFunc mocks the RemovePeer method.
 	RemovePeerFunc func(ctx context.Context, peer privatebtc.Node) error
 
 	// SendCustomTransactionFunc mocks the SendCustomTransaction method.
-	SendCustomTransactionFunc func(ctx context.Context, inputs []privatebtc.TransactionVin, amounts map[string]float64) (string, error)
+	SendCustomTransactionFunc func(ctx context.Context, inputs []privatebtc.TransactionVin, amounts map[string]privatebtc.Amount, lockTime uint32) (string, error)
+
+	// SendRawTransactionFunc mocks the SendRawTransaction method.
+	SendRawTransactionFunc func(ctx context.Context, txHex string) (string, error)
 
 	// SendToAddressFunc mocks the SendToAddress method.
-	SendToAddressFunc func(ctx context.Context, address string, amount float64) (string, error)
+	SendToAddressFunc func(ctx context.Context, address string, amount privatebtc.Amount, opts privatebtc.SendOptions) (string, error)
+
+	// SetMockTimeFunc mocks the SetMockTime method.
+	SetMockTimeFunc func(ctx context.Context, t time.Time) error
+
+	// SetNetworkActiveFunc mocks the SetNetworkActive method.
+	SetNetworkActiveFunc func(ctx context.Context, active bool) error
+
+	// SubmitPackageFunc mocks the SubmitPackage method.
+	SubmitPackageFunc func(ctx context.Context, txHexes []string) (*privatebtc.PackageSubmitResult, error)
+
+	// TestMempoolAcceptFunc mocks the TestMempoolAccept method.
+	TestMempoolAcceptFunc func(ctx context.Context, txHexes []string) ([]privatebtc.MempoolAcceptResult, error)
+
+	// UnbanPeerFunc mocks the UnbanPeer method.
+	UnbanPeerFunc func(ctx context.Context, peer privatebtc.Node) error
+
+	// UnloadWalletFunc mocks the UnloadWallet method.
+	UnloadWalletFunc func(ctx context.Context, walletName string) error
+
+	// UnlockUnspentFunc mocks the UnlockUnspent method.
+	UnlockUnspentFunc func(ctx context.Context, outpoints []privatebtc.TransactionVin) error
+
+	// WalletFunc mocks the Wallet method.
+	WalletFunc func(walletName string) (privatebtc.RPCClient, error)
+
+	// WalletCreateFundedPSBTFunc mocks the WalletCreateFundedPSBT method.
+	WalletCreateFundedPSBTFunc func(ctx context.Context, inputs []privatebtc.TransactionVin, amounts map[string]privatebtc.Amount, opts privatebtc.SendOptions) (*privatebtc.FundedPSBT, error)
+
+	// WalletProcessPSBTFunc mocks the WalletProcessPSBT method.
+	WalletProcessPSBTFunc func(ctx context.Context, psbt string, sign bool) (*privatebtc.ProcessedPSBT, error)
 
 	// calls tracks calls to the methods.
 	calls struct {
@@ -131,6 +348,47 @@ type RPCClient struct {
 			// Peer is the peer argument value.
 			Peer privatebtc.Node
 		}
+		// AnalyzePSBT holds details about calls to the AnalyzePSBT method.
+		AnalyzePSBT []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Psbt is the psbt argument value.
+			Psbt string
+		}
+		// BanPeer holds details about calls to the BanPeer method.
+		BanPeer []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Peer is the peer argument value.
+			Peer privatebtc.Node
+			// Duration is the duration argument value.
+			Duration time.Duration
+		}
+		// BumpFee holds details about calls to the BumpFee method.
+		BumpFee []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// TxHash is the txHash argument value.
+			TxHash string
+			// FeeRate is the feeRate argument value.
+			FeeRate privatebtc.FeeRate
+		}
+		// CombinePSBT holds details about calls to the CombinePSBT method.
+		CombinePSBT []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Psbts is the psbts argument value.
+			Psbts []string
+		}
+		// CreateMultisig holds details about calls to the CreateMultisig method.
+		CreateMultisig []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// NRequired is the nRequired argument value.
+			NRequired int
+			// PubKeys is the pubKeys argument value.
+			PubKeys []string
+		}
 		// CreateWallet holds details about calls to the CreateWallet method.
 		CreateWallet []struct {
 			// Ctx is the ctx argument value.
@@ -138,6 +396,32 @@ type RPCClient struct {
 			// WalletName is the walletName argument value.
 			WalletName string
 		}
+		// CreateWatchOnlyWallet holds details about calls to the CreateWatchOnlyWallet method.
+		CreateWatchOnlyWallet []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// WalletName is the walletName argument value.
+			WalletName string
+		}
+		// DeriveAddress holds details about calls to the DeriveAddress method.
+		DeriveAddress []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Descriptor is the descriptor argument value.
+			Descriptor string
+		}
+		// FinalizePSBT holds details about calls to the FinalizePSBT method.
+		FinalizePSBT []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Psbt is the psbt argument value.
+			Psbt string
+		}
+		// FlushChainState holds details about calls to the FlushChainState method.
+		FlushChainState []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
 		// GenerateToAddress holds details about calls to the GenerateToAddress method.
 		GenerateToAddress []struct {
 			// Ctx is the ctx argument value.
@@ -147,6 +431,13 @@ type RPCClient struct {
 			// Address is the address argument value.
 			Address string
 		}
+		// GetAddressInfo holds details about calls to the GetAddressInfo method.
+		GetAddressInfo []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Address is the address argument value.
+			Address string
+		}
 		// GetBalance holds details about calls to the GetBalance method.
 		GetBalance []struct {
 			// Ctx is the ctx argument value.
@@ -157,11 +448,37 @@ type RPCClient struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
 		}
+		// GetBlock holds details about calls to the GetBlock method.
+		GetBlock []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// BlockHash is the blockHash argument value.
+			BlockHash string
+		}
 		// GetBlockCount holds details about calls to the GetBlockCount method.
 		GetBlockCount []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
 		}
+		// GetBlockHash holds details about calls to the GetBlockHash method.
+		GetBlockHash []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Height is the height argument value.
+			Height int
+		}
+		// GetBlockHeader holds details about calls to the GetBlockHeader method.
+		GetBlockHeader []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// BlockHash is the blockHash argument value.
+			BlockHash string
+		}
+		// GetChainTips holds details about calls to the GetChainTips method.
+		GetChainTips []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
 		// GetCoinbaseValue holds details about calls to the GetCoinbaseValue method.
 		GetCoinbaseValue []struct {
 			// Ctx is the ctx argument value.
@@ -172,6 +489,20 @@ type RPCClient struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
 		}
+		// GetDescriptorInfo holds details about calls to the GetDescriptorInfo method.
+		GetDescriptorInfo []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Descriptor is the descriptor argument value.
+			Descriptor string
+		}
+		// GetMempoolEntry holds details about calls to the GetMempoolEntry method.
+		GetMempoolEntry []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// TxHash is the txHash argument value.
+			TxHash string
+		}
 		// GetNewAddress holds details about calls to the GetNewAddress method.
 		GetNewAddress []struct {
 			// Ctx is the ctx argument value.
@@ -179,6 +510,11 @@ type RPCClient struct {
 			// Label is the label argument value.
 			Label string
 		}
+		// GetPeerInfo holds details about calls to the GetPeerInfo method.
+		GetPeerInfo []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
 		// GetRawMempool holds details about calls to the GetRawMempool method.
 		GetRawMempool []struct {
 			// Ctx is the ctx argument value.
@@ -198,11 +534,65 @@ type RPCClient struct {
 			// TxHash is the txHash argument value.
 			TxHash string
 		}
+		// ImportDescriptors holds details about calls to the ImportDescriptors method.
+		ImportDescriptors []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Requests is the requests argument value.
+			Requests []privatebtc.ImportDescriptorRequest
+		}
 		// ListAddresses holds details about calls to the ListAddresses method.
 		ListAddresses []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
 		}
+		// ListDescriptors holds details about calls to the ListDescriptors method.
+		ListDescriptors []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Private is the private argument value.
+			Private bool
+		}
+		// ListLockUnspent holds details about calls to the ListLockUnspent method.
+		ListLockUnspent []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// ListUnspent holds details about calls to the ListUnspent method.
+		ListUnspent []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Filter is the filter argument value.
+			Filter privatebtc.UTXOFilter
+		}
+		// ListWallets holds details about calls to the ListWallets method.
+		ListWallets []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// LoadWallet holds details about calls to the LoadWallet method.
+		LoadWallet []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// WalletName is the walletName argument value.
+			WalletName string
+		}
+		// LockUnspent holds details about calls to the LockUnspent method.
+		LockUnspent []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Outpoints is the outpoints argument value.
+			Outpoints []privatebtc.TransactionVin
+		}
+		// PSBTBumpFee holds details about calls to the PSBTBumpFee method.
+		PSBTBumpFee []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// TxHash is the txHash argument value.
+			TxHash string
+			// FeeRate is the feeRate argument value.
+			FeeRate privatebtc.FeeRate
+		}
 		// RemovePeer holds details about calls to the RemovePeer method.
 		RemovePeer []struct {
 			// Ctx is the ctx argument value.
@@ -217,7 +607,16 @@ type RPCClient struct {
 			// Inputs is the inputs argument value.
 			Inputs []privatebtc.TransactionVin
 			// Amounts is the amounts argument value.
-			Amounts map[string]float64
+			Amounts map[string]privatebtc.Amount
+			// LockTime is the lockTime argument value.
+			LockTime uint32
+		}
+		// SendRawTransaction holds details about calls to the SendRawTransaction method.
+		SendRawTransaction []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// TxHex is the txHex argument value.
+			TxHex string
 		}
 		// SendToAddress holds details about calls to the SendToAddress method.
 		SendToAddress []struct {
@@ -226,25 +625,137 @@ type RPCClient struct {
 			// Address is the address argument value.
 			Address string
 			// Amount is the amount argument value.
-			Amount float64
-		}
-	}
-	lockAddPeer               sync.RWMutex
-	lockCreateWallet          sync.RWMutex
-	lockGenerateToAddress     sync.RWMutex
-	lockGetBalance            sync.RWMutex
-	lockGetBestBlockHash      sync.RWMutex
-	lockGetBlockCount         sync.RWMutex
-	lockGetCoinbaseValue      sync.RWMutex
-	lockGetConnectionCount    sync.RWMutex
-	lockGetNewAddress         sync.RWMutex
-	lockGetRawMempool         sync.RWMutex
-	lockGetTransaction        sync.RWMutex
-	lockGetTransactionOutputs sync.RWMutex
-	lockListAddresses         sync.RWMutex
-	lockRemovePeer            sync.RWMutex
-	lockSendCustomTransaction sync.RWMutex
-	lockSendToAddress         sync.RWMutex
+			Amount privatebtc.Amount
+			// Opts is the opts argument value.
+			Opts privatebtc.SendOptions
+		}
+		// SetMockTime holds details about calls to the SetMockTime method.
+		SetMockTime []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// T is the t argument value.
+			T time.Time
+		}
+		// SetNetworkActive holds details about calls to the SetNetworkActive method.
+		SetNetworkActive []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Active is the active argument value.
+			Active bool
+		}
+		// SubmitPackage holds details about calls to the SubmitPackage method.
+		SubmitPackage []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// TxHexes is the txHexes argument value.
+			TxHexes []string
+		}
+		// TestMempoolAccept holds details about calls to the TestMempoolAccept method.
+		TestMempoolAccept []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// TxHexes is the txHexes argument value.
+			TxHexes []string
+		}
+		// UnbanPeer holds details about calls to the UnbanPeer method.
+		UnbanPeer []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Peer is the peer argument value.
+			Peer privatebtc.Node
+		}
+		// UnloadWallet holds details about calls to the UnloadWallet method.
+		UnloadWallet []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// WalletName is the walletName argument value.
+			WalletName string
+		}
+		// UnlockUnspent holds details about calls to the UnlockUnspent method.
+		UnlockUnspent []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Outpoints is the outpoints argument value.
+			Outpoints []privatebtc.TransactionVin
+		}
+		// Wallet holds details about calls to the Wallet method.
+		Wallet []struct {
+			// WalletName is the walletName argument value.
+			WalletName string
+		}
+		// WalletCreateFundedPSBT holds details about calls to the WalletCreateFundedPSBT method.
+		WalletCreateFundedPSBT []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Inputs is the inputs argument value.
+			Inputs []privatebtc.TransactionVin
+			// Amounts is the amounts argument value.
+			Amounts map[string]privatebtc.Amount
+			// Opts is the opts argument value.
+			Opts privatebtc.SendOptions
+		}
+		// WalletProcessPSBT holds details about calls to the WalletProcessPSBT method.
+		WalletProcessPSBT []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Psbt is the psbt argument value.
+			Psbt string
+			// Sign is the sign argument value.
+			Sign bool
+		}
+	}
+	lockAddPeer                sync.RWMutex
+	lockAnalyzePSBT            sync.RWMutex
+	lockBanPeer                sync.RWMutex
+	lockBumpFee                sync.RWMutex
+	lockCombinePSBT            sync.RWMutex
+	lockCreateMultisig         sync.RWMutex
+	lockCreateWallet           sync.RWMutex
+	lockCreateWatchOnlyWallet  sync.RWMutex
+	lockDeriveAddress          sync.RWMutex
+	lockFinalizePSBT           sync.RWMutex
+	lockFlushChainState        sync.RWMutex
+	lockGenerateToAddress      sync.RWMutex
+	lockGetAddressInfo         sync.RWMutex
+	lockGetBalance             sync.RWMutex
+	lockGetBestBlockHash       sync.RWMutex
+	lockGetBlock               sync.RWMutex
+	lockGetBlockCount          sync.RWMutex
+	lockGetBlockHash           sync.RWMutex
+	lockGetBlockHeader         sync.RWMutex
+	lockGetChainTips           sync.RWMutex
+	lockGetCoinbaseValue       sync.RWMutex
+	lockGetConnectionCount     sync.RWMutex
+	lockGetDescriptorInfo      sync.RWMutex
+	lockGetMempoolEntry        sync.RWMutex
+	lockGetNewAddress          sync.RWMutex
+	lockGetPeerInfo            sync.RWMutex
+	lockGetRawMempool          sync.RWMutex
+	lockGetTransaction         sync.RWMutex
+	lockGetTransactionOutputs  sync.RWMutex
+	lockImportDescriptors      sync.RWMutex
+	lockListAddresses          sync.RWMutex
+	lockListDescriptors        sync.RWMutex
+	lockListLockUnspent        sync.RWMutex
+	lockListUnspent            sync.RWMutex
+	lockListWallets            sync.RWMutex
+	lockLoadWallet             sync.RWMutex
+	lockLockUnspent            sync.RWMutex
+	lockPSBTBumpFee            sync.RWMutex
+	lockRemovePeer             sync.RWMutex
+	lockSendCustomTransaction  sync.RWMutex
+	lockSendRawTransaction     sync.RWMutex
+	lockSendToAddress          sync.RWMutex
+	lockSetMockTime            sync.RWMutex
+	lockSetNetworkActive       sync.RWMutex
+	lockSubmitPackage          sync.RWMutex
+	lockTestMempoolAccept      sync.RWMutex
+	lockUnbanPeer              sync.RWMutex
+	lockUnloadWallet           sync.RWMutex
+	lockUnlockUnspent          sync.RWMutex
+	lockWallet                 sync.RWMutex
+	lockWalletCreateFundedPSBT sync.RWMutex
+	lockWalletProcessPSBT      sync.RWMutex
 }
 
 // AddPeer calls AddPeerFunc.
@@ -283,83 +794,451 @@ func (mock *RPCClient) AddPeerCalls() []struct {
 	return calls
 }
 
-// CreateWallet calls CreateWalletFunc.
-func (mock *RPCClient) CreateWallet(ctx context.Context, walletName string) error {
-	if mock.CreateWalletFunc == nil {
-		panic("RPCClient.CreateWalletFunc: method is nil but RPCClient.CreateWallet was just called")
+// AnalyzePSBT calls AnalyzePSBTFunc.
+func (mock *RPCClient) AnalyzePSBT(ctx context.Context, psbt string) (*privatebtc.PSBTAnalysis, error) {
+	if mock.AnalyzePSBTFunc == nil {
+		panic("RPCClient.AnalyzePSBTFunc: method is nil but RPCClient.AnalyzePSBT was just called")
 	}
 	callInfo := struct {
-		Ctx        context.Context
-		WalletName string
+		Ctx  context.Context
+		Psbt string
 	}{
-		Ctx:        ctx,
-		WalletName: walletName,
+		Ctx:  ctx,
+		Psbt: psbt,
 	}
-	mock.lockCreateWallet.Lock()
-	mock.calls.CreateWallet = append(mock.calls.CreateWallet, callInfo)
-	mock.lockCreateWallet.Unlock()
-	return mock.CreateWalletFunc(ctx, walletName)
+	mock.lockAnalyzePSBT.Lock()
+	mock.calls.AnalyzePSBT = append(mock.calls.AnalyzePSBT, callInfo)
+	mock.lockAnalyzePSBT.Unlock()
+	return mock.AnalyzePSBTFunc(ctx, psbt)
 }
 
-// CreateWalletCalls gets all the calls that were made to CreateWallet.
+// AnalyzePSBTCalls gets all the calls that were made to AnalyzePSBT.
 // Check the length with:
 //
-//	len(mockedRPCClient.CreateWalletCalls())
-func (mock *RPCClient) CreateWalletCalls() []struct {
-	Ctx        context.Context
-	WalletName string
+//	len(mockedRPCClient.AnalyzePSBTCalls())
+func (mock *RPCClient) AnalyzePSBTCalls() []struct {
+	Ctx  context.Context
+	Psbt string
 } {
 	var calls []struct {
-		Ctx        context.Context
-		WalletName string
+		Ctx  context.Context
+		Psbt string
 	}
-	mock.lockCreateWallet.RLock()
-	calls = mock.calls.CreateWallet
-	mock.lockCreateWallet.RUnlock()
+	mock.lockAnalyzePSBT.RLock()
+	calls = mock.calls.AnalyzePSBT
+	mock.lockAnalyzePSBT.RUnlock()
 	return calls
 }
 
-// GenerateToAddress calls GenerateToAddressFunc.
-func (mock *RPCClient) GenerateToAddress(ctx context.Context, numBlocks int64, address string) ([]string, error) {
-	if mock.GenerateToAddressFunc == nil {
-		panic("RPCClient.GenerateToAddressFunc: method is nil but RPCClient.GenerateToAddress was just called")
+// BanPeer calls BanPeerFunc.
+func (mock *RPCClient) BanPeer(ctx context.Context, peer privatebtc.Node, duration time.Duration) error {
+	if mock.BanPeerFunc == nil {
+		panic("RPCClient.BanPeerFunc: method is nil but RPCClient.BanPeer was just called")
 	}
 	callInfo := struct {
-		Ctx       context.Context
-		NumBlocks int64
-		Address   string
+		Ctx      context.Context
+		Peer     privatebtc.Node
+		Duration time.Duration
 	}{
-		Ctx:       ctx,
-		NumBlocks: numBlocks,
-		Address:   address,
+		Ctx:      ctx,
+		Peer:     peer,
+		Duration: duration,
 	}
-	mock.lockGenerateToAddress.Lock()
-	mock.calls.GenerateToAddress = append(mock.calls.GenerateToAddress, callInfo)
-	mock.lockGenerateToAddress.Unlock()
-	return mock.GenerateToAddressFunc(ctx, numBlocks, address)
+	mock.lockBanPeer.Lock()
+	mock.calls.BanPeer = append(mock.calls.BanPeer, callInfo)
+	mock.lockBanPeer.Unlock()
+	return mock.BanPeerFunc(ctx, peer, duration)
 }
 
-// GenerateToAddressCalls gets all the calls that were made to GenerateToAddress.
+// BanPeerCalls gets all the calls that were made to BanPeer.
 // Check the length with:
 //
-//	len(mockedRPCClient.GenerateToAddressCalls())
-func (mock *RPCClient) GenerateToAddressCalls() []struct {
-	Ctx       context.Context
-	NumBlocks int64
-	Address   string
+//	len(mockedRPCClient.BanPeerCalls())
+func (mock *RPCClient) BanPeerCalls() []struct {
+	Ctx      context.Context
+	Peer     privatebtc.Node
+	Duration time.Duration
 } {
 	var calls []struct {
-		Ctx       context.Context
-		NumBlocks int64
-		Address   string
+		Ctx      context.Context
+		Peer     privatebtc.Node
+		Duration time.Duration
 	}
-	mock.lockGenerateToAddress.RLock()
-	calls = mock.calls.GenerateToAddress
-	mock.lockGenerateToAddress.RUnlock()
+	mock.lockBanPeer.RLock()
+	calls = mock.calls.BanPeer
+	mock.lockBanPeer.RUnlock()
 	return calls
 }
 
-// GetBalance calls GetBalanceFunc.
+// BumpFee calls BumpFeeFunc.
+func (mock *RPCClient) BumpFee(ctx context.Context, txHash string, feeRate privatebtc.FeeRate) (*privatebtc.BumpedTransaction, error) {
+	if mock.BumpFeeFunc == nil {
+		panic("RPCClient.BumpFeeFunc: method is nil but RPCClient.BumpFee was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		TxHash  string
+		FeeRate privatebtc.FeeRate
+	}{
+		Ctx:     ctx,
+		TxHash:  txHash,
+		FeeRate: feeRate,
+	}
+	mock.lockBumpFee.Lock()
+	mock.calls.BumpFee = append(mock.calls.BumpFee, callInfo)
+	mock.lockBumpFee.Unlock()
+	return mock.BumpFeeFunc(ctx, txHash, feeRate)
+}
+
+// BumpFeeCalls gets all the calls that were made to BumpFee.
+// Check the length with:
+//
+//	len(mockedRPCClient.BumpFeeCalls())
+func (mock *RPCClient) BumpFeeCalls() []struct {
+	Ctx     context.Context
+	TxHash  string
+	FeeRate privatebtc.FeeRate
+} {
+	var calls []struct {
+		Ctx     context.Context
+		TxHash  string
+		FeeRate privatebtc.FeeRate
+	}
+	mock.lockBumpFee.RLock()
+	calls = mock.calls.BumpFee
+	mock.lockBumpFee.RUnlock()
+	return calls
+}
+
+// CombinePSBT calls CombinePSBTFunc.
+func (mock *RPCClient) CombinePSBT(ctx context.Context, psbts []string) (string, error) {
+	if mock.CombinePSBTFunc == nil {
+		panic("RPCClient.CombinePSBTFunc: method is nil but RPCClient.CombinePSBT was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Psbts []string
+	}{
+		Ctx:   ctx,
+		Psbts: psbts,
+	}
+	mock.lockCombinePSBT.Lock()
+	mock.calls.CombinePSBT = append(mock.calls.CombinePSBT, callInfo)
+	mock.lockCombinePSBT.Unlock()
+	return mock.CombinePSBTFunc(ctx, psbts)
+}
+
+// CombinePSBTCalls gets all the calls that were made to CombinePSBT.
+// Check the length with:
+//
+//	len(mockedRPCClient.CombinePSBTCalls())
+func (mock *RPCClient) CombinePSBTCalls() []struct {
+	Ctx   context.Context
+	Psbts []string
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Psbts []string
+	}
+	mock.lockCombinePSBT.RLock()
+	calls = mock.calls.CombinePSBT
+	mock.lockCombinePSBT.RUnlock()
+	return calls
+}
+
+// CreateMultisig calls CreateMultisigFunc.
+func (mock *RPCClient) CreateMultisig(ctx context.Context, nRequired int, pubKeys []string) (*privatebtc.Multisig, error) {
+	if mock.CreateMultisigFunc == nil {
+		panic("RPCClient.CreateMultisigFunc: method is nil but RPCClient.CreateMultisig was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		NRequired int
+		PubKeys   []string
+	}{
+		Ctx:       ctx,
+		NRequired: nRequired,
+		PubKeys:   pubKeys,
+	}
+	mock.lockCreateMultisig.Lock()
+	mock.calls.CreateMultisig = append(mock.calls.CreateMultisig, callInfo)
+	mock.lockCreateMultisig.Unlock()
+	return mock.CreateMultisigFunc(ctx, nRequired, pubKeys)
+}
+
+// CreateMultisigCalls gets all the calls that were made to CreateMultisig.
+// Check the length with:
+//
+//	len(mockedRPCClient.CreateMultisigCalls())
+func (mock *RPCClient) CreateMultisigCalls() []struct {
+	Ctx       context.Context
+	NRequired int
+	PubKeys   []string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		NRequired int
+		PubKeys   []string
+	}
+	mock.lockCreateMultisig.RLock()
+	calls = mock.calls.CreateMultisig
+	mock.lockCreateMultisig.RUnlock()
+	return calls
+}
+
+// CreateWallet calls CreateWalletFunc.
+func (mock *RPCClient) CreateWallet(ctx context.Context, walletName string) error {
+	if mock.CreateWalletFunc == nil {
+		panic("RPCClient.CreateWalletFunc: method is nil but RPCClient.CreateWallet was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		WalletName string
+	}{
+		Ctx:        ctx,
+		WalletName: walletName,
+	}
+	mock.lockCreateWallet.Lock()
+	mock.calls.CreateWallet = append(mock.calls.CreateWallet, callInfo)
+	mock.lockCreateWallet.Unlock()
+	return mock.CreateWalletFunc(ctx, walletName)
+}
+
+// CreateWalletCalls gets all the calls that were made to CreateWallet.
+// Check the length with:
+//
+//	len(mockedRPCClient.CreateWalletCalls())
+func (mock *RPCClient) CreateWalletCalls() []struct {
+	Ctx        context.Context
+	WalletName string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		WalletName string
+	}
+	mock.lockCreateWallet.RLock()
+	calls = mock.calls.CreateWallet
+	mock.lockCreateWallet.RUnlock()
+	return calls
+}
+
+// CreateWatchOnlyWallet calls CreateWatchOnlyWalletFunc.
+func (mock *RPCClient) CreateWatchOnlyWallet(ctx context.Context, walletName string) error {
+	if mock.CreateWatchOnlyWalletFunc == nil {
+		panic("RPCClient.CreateWatchOnlyWalletFunc: method is nil but RPCClient.CreateWatchOnlyWallet was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		WalletName string
+	}{
+		Ctx:        ctx,
+		WalletName: walletName,
+	}
+	mock.lockCreateWatchOnlyWallet.Lock()
+	mock.calls.CreateWatchOnlyWallet = append(mock.calls.CreateWatchOnlyWallet, callInfo)
+	mock.lockCreateWatchOnlyWallet.Unlock()
+	return mock.CreateWatchOnlyWalletFunc(ctx, walletName)
+}
+
+// CreateWatchOnlyWalletCalls gets all the calls that were made to CreateWatchOnlyWallet.
+// Check the length with:
+//
+//	len(mockedRPCClient.CreateWatchOnlyWalletCalls())
+func (mock *RPCClient) CreateWatchOnlyWalletCalls() []struct {
+	Ctx        context.Context
+	WalletName string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		WalletName string
+	}
+	mock.lockCreateWatchOnlyWallet.RLock()
+	calls = mock.calls.CreateWatchOnlyWallet
+	mock.lockCreateWatchOnlyWallet.RUnlock()
+	return calls
+}
+
+// DeriveAddress calls DeriveAddressFunc.
+func (mock *RPCClient) DeriveAddress(ctx context.Context, descriptor string) (string, error) {
+	if mock.DeriveAddressFunc == nil {
+		panic("RPCClient.DeriveAddressFunc: method is nil but RPCClient.DeriveAddress was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Descriptor string
+	}{
+		Ctx:        ctx,
+		Descriptor: descriptor,
+	}
+	mock.lockDeriveAddress.Lock()
+	mock.calls.DeriveAddress = append(mock.calls.DeriveAddress, callInfo)
+	mock.lockDeriveAddress.Unlock()
+	return mock.DeriveAddressFunc(ctx, descriptor)
+}
+
+// DeriveAddressCalls gets all the calls that were made to DeriveAddress.
+// Check the length with:
+//
+//	len(mockedRPCClient.DeriveAddressCalls())
+func (mock *RPCClient) DeriveAddressCalls() []struct {
+	Ctx        context.Context
+	Descriptor string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Descriptor string
+	}
+	mock.lockDeriveAddress.RLock()
+	calls = mock.calls.DeriveAddress
+	mock.lockDeriveAddress.RUnlock()
+	return calls
+}
+
+// FinalizePSBT calls FinalizePSBTFunc.
+func (mock *RPCClient) FinalizePSBT(ctx context.Context, psbt string) (*privatebtc.FinalizedPSBT, error) {
+	if mock.FinalizePSBTFunc == nil {
+		panic("RPCClient.FinalizePSBTFunc: method is nil but RPCClient.FinalizePSBT was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Psbt string
+	}{
+		Ctx:  ctx,
+		Psbt: psbt,
+	}
+	mock.lockFinalizePSBT.Lock()
+	mock.calls.FinalizePSBT = append(mock.calls.FinalizePSBT, callInfo)
+	mock.lockFinalizePSBT.Unlock()
+	return mock.FinalizePSBTFunc(ctx, psbt)
+}
+
+// FinalizePSBTCalls gets all the calls that were made to FinalizePSBT.
+// Check the length with:
+//
+//	len(mockedRPCClient.FinalizePSBTCalls())
+func (mock *RPCClient) FinalizePSBTCalls() []struct {
+	Ctx  context.Context
+	Psbt string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Psbt string
+	}
+	mock.lockFinalizePSBT.RLock()
+	calls = mock.calls.FinalizePSBT
+	mock.lockFinalizePSBT.RUnlock()
+	return calls
+}
+
+// FlushChainState calls FlushChainStateFunc.
+func (mock *RPCClient) FlushChainState(ctx context.Context) error {
+	if mock.FlushChainStateFunc == nil {
+		panic("RPCClient.FlushChainStateFunc: method is nil but RPCClient.FlushChainState was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockFlushChainState.Lock()
+	mock.calls.FlushChainState = append(mock.calls.FlushChainState, callInfo)
+	mock.lockFlushChainState.Unlock()
+	return mock.FlushChainStateFunc(ctx)
+}
+
+// FlushChainStateCalls gets all the calls that were made to FlushChainState.
+// Check the length with:
+//
+//	len(mockedRPCClient.FlushChainStateCalls())
+func (mock *RPCClient) FlushChainStateCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockFlushChainState.RLock()
+	calls = mock.calls.FlushChainState
+	mock.lockFlushChainState.RUnlock()
+	return calls
+}
+
+// GenerateToAddress calls GenerateToAddressFunc.
+func (mock *RPCClient) GenerateToAddress(ctx context.Context, numBlocks int64, address string) ([]string, error) {
+	if mock.GenerateToAddressFunc == nil {
+		panic("RPCClient.GenerateToAddressFunc: method is nil but RPCClient.GenerateToAddress was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		NumBlocks int64
+		Address   string
+	}{
+		Ctx:       ctx,
+		NumBlocks: numBlocks,
+		Address:   address,
+	}
+	mock.lockGenerateToAddress.Lock()
+	mock.calls.GenerateToAddress = append(mock.calls.GenerateToAddress, callInfo)
+	mock.lockGenerateToAddress.Unlock()
+	return mock.GenerateToAddressFunc(ctx, numBlocks, address)
+}
+
+// GenerateToAddressCalls gets all the calls that were made to GenerateToAddress.
+// Check the length with:
+//
+//	len(mockedRPCClient.GenerateToAddressCalls())
+func (mock *RPCClient) GenerateToAddressCalls() []struct {
+	Ctx       context.Context
+	NumBlocks int64
+	Address   string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		NumBlocks int64
+		Address   string
+	}
+	mock.lockGenerateToAddress.RLock()
+	calls = mock.calls.GenerateToAddress
+	mock.lockGenerateToAddress.RUnlock()
+	return calls
+}
+
+// GetAddressInfo calls GetAddressInfoFunc.
+func (mock *RPCClient) GetAddressInfo(ctx context.Context, address string) (*privatebtc.AddressInfo, error) {
+	if mock.GetAddressInfoFunc == nil {
+		panic("RPCClient.GetAddressInfoFunc: method is nil but RPCClient.GetAddressInfo was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Address string
+	}{
+		Ctx:     ctx,
+		Address: address,
+	}
+	mock.lockGetAddressInfo.Lock()
+	mock.calls.GetAddressInfo = append(mock.calls.GetAddressInfo, callInfo)
+	mock.lockGetAddressInfo.Unlock()
+	return mock.GetAddressInfoFunc(ctx, address)
+}
+
+// GetAddressInfoCalls gets all the calls that were made to GetAddressInfo.
+// Check the length with:
+//
+//	len(mockedRPCClient.GetAddressInfoCalls())
+func (mock *RPCClient) GetAddressInfoCalls() []struct {
+	Ctx     context.Context
+	Address string
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Address string
+	}
+	mock.lockGetAddressInfo.RLock()
+	calls = mock.calls.GetAddressInfo
+	mock.lockGetAddressInfo.RUnlock()
+	return calls
+}
+
+// GetBalance calls GetBalanceFunc.
 func (mock *RPCClient) GetBalance(ctx context.Context) (privatebtc.Balance, error) {
 	if mock.GetBalanceFunc == nil {
 		panic("RPCClient.GetBalanceFunc: method is nil but RPCClient.GetBalance was just called")
@@ -423,6 +1302,42 @@ func (mock *RPCClient) GetBestBlockHashCalls() []struct {
 	return calls
 }
 
+// GetBlock calls GetBlockFunc.
+func (mock *RPCClient) GetBlock(ctx context.Context, blockHash string) (*privatebtc.Block, error) {
+	if mock.GetBlockFunc == nil {
+		panic("RPCClient.GetBlockFunc: method is nil but RPCClient.GetBlock was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		BlockHash string
+	}{
+		Ctx:       ctx,
+		BlockHash: blockHash,
+	}
+	mock.lockGetBlock.Lock()
+	mock.calls.GetBlock = append(mock.calls.GetBlock, callInfo)
+	mock.lockGetBlock.Unlock()
+	return mock.GetBlockFunc(ctx, blockHash)
+}
+
+// GetBlockCalls gets all the calls that were made to GetBlock.
+// Check the length with:
+//
+//	len(mockedRPCClient.GetBlockCalls())
+func (mock *RPCClient) GetBlockCalls() []struct {
+	Ctx       context.Context
+	BlockHash string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		BlockHash string
+	}
+	mock.lockGetBlock.RLock()
+	calls = mock.calls.GetBlock
+	mock.lockGetBlock.RUnlock()
+	return calls
+}
+
 // GetBlockCount calls GetBlockCountFunc.
 func (mock *RPCClient) GetBlockCount(ctx context.Context) (int, error) {
 	if mock.GetBlockCountFunc == nil {
@@ -433,376 +1348,1280 @@ func (mock *RPCClient) GetBlockCount(ctx context.Context) (int, error) {
 	}{
 		Ctx: ctx,
 	}
-	mock.lockGetBlockCount.Lock()
-	mock.calls.GetBlockCount = append(mock.calls.GetBlockCount, callInfo)
-	mock.lockGetBlockCount.Unlock()
-	return mock.GetBlockCountFunc(ctx)
+	mock.lockGetBlockCount.Lock()
+	mock.calls.GetBlockCount = append(mock.calls.GetBlockCount, callInfo)
+	mock.lockGetBlockCount.Unlock()
+	return mock.GetBlockCountFunc(ctx)
+}
+
+// GetBlockCountCalls gets all the calls that were made to GetBlockCount.
+// Check the length with:
+//
+//	len(mockedRPCClient.GetBlockCountCalls())
+func (mock *RPCClient) GetBlockCountCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetBlockCount.RLock()
+	calls = mock.calls.GetBlockCount
+	mock.lockGetBlockCount.RUnlock()
+	return calls
+}
+
+// GetBlockHash calls GetBlockHashFunc.
+func (mock *RPCClient) GetBlockHash(ctx context.Context, height int) (string, error) {
+	if mock.GetBlockHashFunc == nil {
+		panic("RPCClient.GetBlockHashFunc: method is nil but RPCClient.GetBlockHash was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Height int
+	}{
+		Ctx:    ctx,
+		Height: height,
+	}
+	mock.lockGetBlockHash.Lock()
+	mock.calls.GetBlockHash = append(mock.calls.GetBlockHash, callInfo)
+	mock.lockGetBlockHash.Unlock()
+	return mock.GetBlockHashFunc(ctx, height)
+}
+
+// GetBlockHashCalls gets all the calls that were made to GetBlockHash.
+// Check the length with:
+//
+//	len(mockedRPCClient.GetBlockHashCalls())
+func (mock *RPCClient) GetBlockHashCalls() []struct {
+	Ctx    context.Context
+	Height int
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Height int
+	}
+	mock.lockGetBlockHash.RLock()
+	calls = mock.calls.GetBlockHash
+	mock.lockGetBlockHash.RUnlock()
+	return calls
+}
+
+// GetBlockHeader calls GetBlockHeaderFunc.
+func (mock *RPCClient) GetBlockHeader(ctx context.Context, blockHash string) (*privatebtc.BlockHeader, error) {
+	if mock.GetBlockHeaderFunc == nil {
+		panic("RPCClient.GetBlockHeaderFunc: method is nil but RPCClient.GetBlockHeader was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		BlockHash string
+	}{
+		Ctx:       ctx,
+		BlockHash: blockHash,
+	}
+	mock.lockGetBlockHeader.Lock()
+	mock.calls.GetBlockHeader = append(mock.calls.GetBlockHeader, callInfo)
+	mock.lockGetBlockHeader.Unlock()
+	return mock.GetBlockHeaderFunc(ctx, blockHash)
+}
+
+// GetBlockHeaderCalls gets all the calls that were made to GetBlockHeader.
+// Check the length with:
+//
+//	len(mockedRPCClient.GetBlockHeaderCalls())
+func (mock *RPCClient) GetBlockHeaderCalls() []struct {
+	Ctx       context.Context
+	BlockHash string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		BlockHash string
+	}
+	mock.lockGetBlockHeader.RLock()
+	calls = mock.calls.GetBlockHeader
+	mock.lockGetBlockHeader.RUnlock()
+	return calls
+}
+
+// GetChainTips calls GetChainTipsFunc.
+func (mock *RPCClient) GetChainTips(ctx context.Context) ([]privatebtc.ChainTip, error) {
+	if mock.GetChainTipsFunc == nil {
+		panic("RPCClient.GetChainTipsFunc: method is nil but RPCClient.GetChainTips was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetChainTips.Lock()
+	mock.calls.GetChainTips = append(mock.calls.GetChainTips, callInfo)
+	mock.lockGetChainTips.Unlock()
+	return mock.GetChainTipsFunc(ctx)
+}
+
+// GetChainTipsCalls gets all the calls that were made to GetChainTips.
+// Check the length with:
+//
+//	len(mockedRPCClient.GetChainTipsCalls())
+func (mock *RPCClient) GetChainTipsCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetChainTips.RLock()
+	calls = mock.calls.GetChainTips
+	mock.lockGetChainTips.RUnlock()
+	return calls
+}
+
+// GetCoinbaseValue calls GetCoinbaseValueFunc.
+func (mock *RPCClient) GetCoinbaseValue(ctx context.Context) (privatebtc.Amount, error) {
+	if mock.GetCoinbaseValueFunc == nil {
+		panic("RPCClient.GetCoinbaseValueFunc: method is nil but RPCClient.GetCoinbaseValue was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetCoinbaseValue.Lock()
+	mock.calls.GetCoinbaseValue = append(mock.calls.GetCoinbaseValue, callInfo)
+	mock.lockGetCoinbaseValue.Unlock()
+	return mock.GetCoinbaseValueFunc(ctx)
+}
+
+// GetCoinbaseValueCalls gets all the calls that were made to GetCoinbaseValue.
+// Check the length with:
+//
+//	len(mockedRPCClient.GetCoinbaseValueCalls())
+func (mock *RPCClient) GetCoinbaseValueCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetCoinbaseValue.RLock()
+	calls = mock.calls.GetCoinbaseValue
+	mock.lockGetCoinbaseValue.RUnlock()
+	return calls
+}
+
+// GetConnectionCount calls GetConnectionCountFunc.
+func (mock *RPCClient) GetConnectionCount(ctx context.Context) (int, error) {
+	if mock.GetConnectionCountFunc == nil {
+		panic("RPCClient.GetConnectionCountFunc: method is nil but RPCClient.GetConnectionCount was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetConnectionCount.Lock()
+	mock.calls.GetConnectionCount = append(mock.calls.GetConnectionCount, callInfo)
+	mock.lockGetConnectionCount.Unlock()
+	return mock.GetConnectionCountFunc(ctx)
+}
+
+// GetConnectionCountCalls gets all the calls that were made to GetConnectionCount.
+// Check the length with:
+//
+//	len(mockedRPCClient.GetConnectionCountCalls())
+func (mock *RPCClient) GetConnectionCountCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetConnectionCount.RLock()
+	calls = mock.calls.GetConnectionCount
+	mock.lockGetConnectionCount.RUnlock()
+	return calls
+}
+
+// GetDescriptorInfo calls GetDescriptorInfoFunc.
+func (mock *RPCClient) GetDescriptorInfo(ctx context.Context, descriptor string) (*privatebtc.DescriptorInfo, error) {
+	if mock.GetDescriptorInfoFunc == nil {
+		panic("RPCClient.GetDescriptorInfoFunc: method is nil but RPCClient.GetDescriptorInfo was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Descriptor string
+	}{
+		Ctx:        ctx,
+		Descriptor: descriptor,
+	}
+	mock.lockGetDescriptorInfo.Lock()
+	mock.calls.GetDescriptorInfo = append(mock.calls.GetDescriptorInfo, callInfo)
+	mock.lockGetDescriptorInfo.Unlock()
+	return mock.GetDescriptorInfoFunc(ctx, descriptor)
+}
+
+// GetDescriptorInfoCalls gets all the calls that were made to GetDescriptorInfo.
+// Check the length with:
+//
+//	len(mockedRPCClient.GetDescriptorInfoCalls())
+func (mock *RPCClient) GetDescriptorInfoCalls() []struct {
+	Ctx        context.Context
+	Descriptor string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Descriptor string
+	}
+	mock.lockGetDescriptorInfo.RLock()
+	calls = mock.calls.GetDescriptorInfo
+	mock.lockGetDescriptorInfo.RUnlock()
+	return calls
+}
+
+// GetMempoolEntry calls GetMempoolEntryFunc.
+func (mock *RPCClient) GetMempoolEntry(ctx context.Context, txHash string) (*privatebtc.MempoolEntry, error) {
+	if mock.GetMempoolEntryFunc == nil {
+		panic("RPCClient.GetMempoolEntryFunc: method is nil but RPCClient.GetMempoolEntry was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		TxHash string
+	}{
+		Ctx:    ctx,
+		TxHash: txHash,
+	}
+	mock.lockGetMempoolEntry.Lock()
+	mock.calls.GetMempoolEntry = append(mock.calls.GetMempoolEntry, callInfo)
+	mock.lockGetMempoolEntry.Unlock()
+	return mock.GetMempoolEntryFunc(ctx, txHash)
+}
+
+// GetMempoolEntryCalls gets all the calls that were made to GetMempoolEntry.
+// Check the length with:
+//
+//	len(mockedRPCClient.GetMempoolEntryCalls())
+func (mock *RPCClient) GetMempoolEntryCalls() []struct {
+	Ctx    context.Context
+	TxHash string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		TxHash string
+	}
+	mock.lockGetMempoolEntry.RLock()
+	calls = mock.calls.GetMempoolEntry
+	mock.lockGetMempoolEntry.RUnlock()
+	return calls
+}
+
+// GetNewAddress calls GetNewAddressFunc.
+func (mock *RPCClient) GetNewAddress(ctx context.Context, label string) (string, error) {
+	if mock.GetNewAddressFunc == nil {
+		panic("RPCClient.GetNewAddressFunc: method is nil but RPCClient.GetNewAddress was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Label string
+	}{
+		Ctx:   ctx,
+		Label: label,
+	}
+	mock.lockGetNewAddress.Lock()
+	mock.calls.GetNewAddress = append(mock.calls.GetNewAddress, callInfo)
+	mock.lockGetNewAddress.Unlock()
+	return mock.GetNewAddressFunc(ctx, label)
+}
+
+// GetNewAddressCalls gets all the calls that were made to GetNewAddress.
+// Check the length with:
+//
+//	len(mockedRPCClient.GetNewAddressCalls())
+func (mock *RPCClient) GetNewAddressCalls() []struct {
+	Ctx   context.Context
+	Label string
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Label string
+	}
+	mock.lockGetNewAddress.RLock()
+	calls = mock.calls.GetNewAddress
+	mock.lockGetNewAddress.RUnlock()
+	return calls
+}
+
+// GetPeerInfo calls GetPeerInfoFunc.
+func (mock *RPCClient) GetPeerInfo(ctx context.Context) ([]privatebtc.Peer, error) {
+	if mock.GetPeerInfoFunc == nil {
+		panic("RPCClient.GetPeerInfoFunc: method is nil but RPCClient.GetPeerInfo was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetPeerInfo.Lock()
+	mock.calls.GetPeerInfo = append(mock.calls.GetPeerInfo, callInfo)
+	mock.lockGetPeerInfo.Unlock()
+	return mock.GetPeerInfoFunc(ctx)
+}
+
+// GetPeerInfoCalls gets all the calls that were made to GetPeerInfo.
+// Check the length with:
+//
+//	len(mockedRPCClient.GetPeerInfoCalls())
+func (mock *RPCClient) GetPeerInfoCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetPeerInfo.RLock()
+	calls = mock.calls.GetPeerInfo
+	mock.lockGetPeerInfo.RUnlock()
+	return calls
+}
+
+// GetRawMempool calls GetRawMempoolFunc.
+func (mock *RPCClient) GetRawMempool(ctx context.Context) ([]string, error) {
+	if mock.GetRawMempoolFunc == nil {
+		panic("RPCClient.GetRawMempoolFunc: method is nil but RPCClient.GetRawMempool was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetRawMempool.Lock()
+	mock.calls.GetRawMempool = append(mock.calls.GetRawMempool, callInfo)
+	mock.lockGetRawMempool.Unlock()
+	return mock.GetRawMempoolFunc(ctx)
+}
+
+// GetRawMempoolCalls gets all the calls that were made to GetRawMempool.
+// Check the length with:
+//
+//	len(mockedRPCClient.GetRawMempoolCalls())
+func (mock *RPCClient) GetRawMempoolCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetRawMempool.RLock()
+	calls = mock.calls.GetRawMempool
+	mock.lockGetRawMempool.RUnlock()
+	return calls
+}
+
+// GetTransaction calls GetTransactionFunc.
+func (mock *RPCClient) GetTransaction(ctx context.Context, txHash string) (*privatebtc.Transaction, error) {
+	if mock.GetTransactionFunc == nil {
+		panic("RPCClient.GetTransactionFunc: method is nil but RPCClient.GetTransaction was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		TxHash string
+	}{
+		Ctx:    ctx,
+		TxHash: txHash,
+	}
+	mock.lockGetTransaction.Lock()
+	mock.calls.GetTransaction = append(mock.calls.GetTransaction, callInfo)
+	mock.lockGetTransaction.Unlock()
+	return mock.GetTransactionFunc(ctx, txHash)
+}
+
+// GetTransactionCalls gets all the calls that were made to GetTransaction.
+// Check the length with:
+//
+//	len(mockedRPCClient.GetTransactionCalls())
+func (mock *RPCClient) GetTransactionCalls() []struct {
+	Ctx    context.Context
+	TxHash string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		TxHash string
+	}
+	mock.lockGetTransaction.RLock()
+	calls = mock.calls.GetTransaction
+	mock.lockGetTransaction.RUnlock()
+	return calls
+}
+
+// GetTransactionOutputs calls GetTransactionOutputsFunc.
+func (mock *RPCClient) GetTransactionOutputs(ctx context.Context, txHash string) ([]privatebtc.MempoolTransactionOutput, error) {
+	if mock.GetTransactionOutputsFunc == nil {
+		panic("RPCClient.GetTransactionOutputsFunc: method is nil but RPCClient.GetTransactionOutputs was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		TxHash string
+	}{
+		Ctx:    ctx,
+		TxHash: txHash,
+	}
+	mock.lockGetTransactionOutputs.Lock()
+	mock.calls.GetTransactionOutputs = append(mock.calls.GetTransactionOutputs, callInfo)
+	mock.lockGetTransactionOutputs.Unlock()
+	return mock.GetTransactionOutputsFunc(ctx, txHash)
+}
+
+// GetTransactionOutputsCalls gets all the calls that were made to GetTransactionOutputs.
+// Check the length with:
+//
+//	len(mockedRPCClient.GetTransactionOutputsCalls())
+func (mock *RPCClient) GetTransactionOutputsCalls() []struct {
+	Ctx    context.Context
+	TxHash string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		TxHash string
+	}
+	mock.lockGetTransactionOutputs.RLock()
+	calls = mock.calls.GetTransactionOutputs
+	mock.lockGetTransactionOutputs.RUnlock()
+	return calls
+}
+
+// ImportDescriptors calls ImportDescriptorsFunc.
+func (mock *RPCClient) ImportDescriptors(ctx context.Context, requests []privatebtc.ImportDescriptorRequest) error {
+	if mock.ImportDescriptorsFunc == nil {
+		panic("RPCClient.ImportDescriptorsFunc: method is nil but RPCClient.ImportDescriptors was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Requests []privatebtc.ImportDescriptorRequest
+	}{
+		Ctx:      ctx,
+		Requests: requests,
+	}
+	mock.lockImportDescriptors.Lock()
+	mock.calls.ImportDescriptors = append(mock.calls.ImportDescriptors, callInfo)
+	mock.lockImportDescriptors.Unlock()
+	return mock.ImportDescriptorsFunc(ctx, requests)
+}
+
+// ImportDescriptorsCalls gets all the calls that were made to ImportDescriptors.
+// Check the length with:
+//
+//	len(mockedRPCClient.ImportDescriptorsCalls())
+func (mock *RPCClient) ImportDescriptorsCalls() []struct {
+	Ctx      context.Context
+	Requests []privatebtc.ImportDescriptorRequest
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Requests []privatebtc.ImportDescriptorRequest
+	}
+	mock.lockImportDescriptors.RLock()
+	calls = mock.calls.ImportDescriptors
+	mock.lockImportDescriptors.RUnlock()
+	return calls
+}
+
+// ListAddresses calls ListAddressesFunc.
+func (mock *RPCClient) ListAddresses(ctx context.Context) ([]string, error) {
+	if mock.ListAddressesFunc == nil {
+		panic("RPCClient.ListAddressesFunc: method is nil but RPCClient.ListAddresses was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockListAddresses.Lock()
+	mock.calls.ListAddresses = append(mock.calls.ListAddresses, callInfo)
+	mock.lockListAddresses.Unlock()
+	return mock.ListAddressesFunc(ctx)
+}
+
+// ListAddressesCalls gets all the calls that were made to ListAddresses.
+// Check the length with:
+//
+//	len(mockedRPCClient.ListAddressesCalls())
+func (mock *RPCClient) ListAddressesCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockListAddresses.RLock()
+	calls = mock.calls.ListAddresses
+	mock.lockListAddresses.RUnlock()
+	return calls
+}
+
+// ListDescriptors calls ListDescriptorsFunc.
+func (mock *RPCClient) ListDescriptors(ctx context.Context, private bool) ([]privatebtc.Descriptor, error) {
+	if mock.ListDescriptorsFunc == nil {
+		panic("RPCClient.ListDescriptorsFunc: method is nil but RPCClient.ListDescriptors was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Private bool
+	}{
+		Ctx:     ctx,
+		Private: private,
+	}
+	mock.lockListDescriptors.Lock()
+	mock.calls.ListDescriptors = append(mock.calls.ListDescriptors, callInfo)
+	mock.lockListDescriptors.Unlock()
+	return mock.ListDescriptorsFunc(ctx, private)
+}
+
+// ListDescriptorsCalls gets all the calls that were made to ListDescriptors.
+// Check the length with:
+//
+//	len(mockedRPCClient.ListDescriptorsCalls())
+func (mock *RPCClient) ListDescriptorsCalls() []struct {
+	Ctx     context.Context
+	Private bool
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Private bool
+	}
+	mock.lockListDescriptors.RLock()
+	calls = mock.calls.ListDescriptors
+	mock.lockListDescriptors.RUnlock()
+	return calls
+}
+
+// ListLockUnspent calls ListLockUnspentFunc.
+func (mock *RPCClient) ListLockUnspent(ctx context.Context) ([]privatebtc.TransactionVin, error) {
+	if mock.ListLockUnspentFunc == nil {
+		panic("RPCClient.ListLockUnspentFunc: method is nil but RPCClient.ListLockUnspent was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockListLockUnspent.Lock()
+	mock.calls.ListLockUnspent = append(mock.calls.ListLockUnspent, callInfo)
+	mock.lockListLockUnspent.Unlock()
+	return mock.ListLockUnspentFunc(ctx)
+}
+
+// ListLockUnspentCalls gets all the calls that were made to ListLockUnspent.
+// Check the length with:
+//
+//	len(mockedRPCClient.ListLockUnspentCalls())
+func (mock *RPCClient) ListLockUnspentCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockListLockUnspent.RLock()
+	calls = mock.calls.ListLockUnspent
+	mock.lockListLockUnspent.RUnlock()
+	return calls
+}
+
+// ListUnspent calls ListUnspentFunc.
+func (mock *RPCClient) ListUnspent(ctx context.Context, filter privatebtc.UTXOFilter) ([]privatebtc.UTXO, error) {
+	if mock.ListUnspentFunc == nil {
+		panic("RPCClient.ListUnspentFunc: method is nil but RPCClient.ListUnspent was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Filter privatebtc.UTXOFilter
+	}{
+		Ctx:    ctx,
+		Filter: filter,
+	}
+	mock.lockListUnspent.Lock()
+	mock.calls.ListUnspent = append(mock.calls.ListUnspent, callInfo)
+	mock.lockListUnspent.Unlock()
+	return mock.ListUnspentFunc(ctx, filter)
+}
+
+// ListUnspentCalls gets all the calls that were made to ListUnspent.
+// Check the length with:
+//
+//	len(mockedRPCClient.ListUnspentCalls())
+func (mock *RPCClient) ListUnspentCalls() []struct {
+	Ctx    context.Context
+	Filter privatebtc.UTXOFilter
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Filter privatebtc.UTXOFilter
+	}
+	mock.lockListUnspent.RLock()
+	calls = mock.calls.ListUnspent
+	mock.lockListUnspent.RUnlock()
+	return calls
+}
+
+// ListWallets calls ListWalletsFunc.
+func (mock *RPCClient) ListWallets(ctx context.Context) ([]string, error) {
+	if mock.ListWalletsFunc == nil {
+		panic("RPCClient.ListWalletsFunc: method is nil but RPCClient.ListWallets was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockListWallets.Lock()
+	mock.calls.ListWallets = append(mock.calls.ListWallets, callInfo)
+	mock.lockListWallets.Unlock()
+	return mock.ListWalletsFunc(ctx)
+}
+
+// ListWalletsCalls gets all the calls that were made to ListWallets.
+// Check the length with:
+//
+//	len(mockedRPCClient.ListWalletsCalls())
+func (mock *RPCClient) ListWalletsCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockListWallets.RLock()
+	calls = mock.calls.ListWallets
+	mock.lockListWallets.RUnlock()
+	return calls
+}
+
+// LoadWallet calls LoadWalletFunc.
+func (mock *RPCClient) LoadWallet(ctx context.Context, walletName string) error {
+	if mock.LoadWalletFunc == nil {
+		panic("RPCClient.LoadWalletFunc: method is nil but RPCClient.LoadWallet was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		WalletName string
+	}{
+		Ctx:        ctx,
+		WalletName: walletName,
+	}
+	mock.lockLoadWallet.Lock()
+	mock.calls.LoadWallet = append(mock.calls.LoadWallet, callInfo)
+	mock.lockLoadWallet.Unlock()
+	return mock.LoadWalletFunc(ctx, walletName)
+}
+
+// LoadWalletCalls gets all the calls that were made to LoadWallet.
+// Check the length with:
+//
+//	len(mockedRPCClient.LoadWalletCalls())
+func (mock *RPCClient) LoadWalletCalls() []struct {
+	Ctx        context.Context
+	WalletName string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		WalletName string
+	}
+	mock.lockLoadWallet.RLock()
+	calls = mock.calls.LoadWallet
+	mock.lockLoadWallet.RUnlock()
+	return calls
+}
+
+// LockUnspent calls LockUnspentFunc.
+func (mock *RPCClient) LockUnspent(ctx context.Context, outpoints []privatebtc.TransactionVin) error {
+	if mock.LockUnspentFunc == nil {
+		panic("RPCClient.LockUnspentFunc: method is nil but RPCClient.LockUnspent was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Outpoints []privatebtc.TransactionVin
+	}{
+		Ctx:       ctx,
+		Outpoints: outpoints,
+	}
+	mock.lockLockUnspent.Lock()
+	mock.calls.LockUnspent = append(mock.calls.LockUnspent, callInfo)
+	mock.lockLockUnspent.Unlock()
+	return mock.LockUnspentFunc(ctx, outpoints)
+}
+
+// LockUnspentCalls gets all the calls that were made to LockUnspent.
+// Check the length with:
+//
+//	len(mockedRPCClient.LockUnspentCalls())
+func (mock *RPCClient) LockUnspentCalls() []struct {
+	Ctx       context.Context
+	Outpoints []privatebtc.TransactionVin
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Outpoints []privatebtc.TransactionVin
+	}
+	mock.lockLockUnspent.RLock()
+	calls = mock.calls.LockUnspent
+	mock.lockLockUnspent.RUnlock()
+	return calls
+}
+
+// PSBTBumpFee calls PSBTBumpFeeFunc.
+func (mock *RPCClient) PSBTBumpFee(ctx context.Context, txHash string, feeRate privatebtc.FeeRate) (*privatebtc.BumpedTransaction, error) {
+	if mock.PSBTBumpFeeFunc == nil {
+		panic("RPCClient.PSBTBumpFeeFunc: method is nil but RPCClient.PSBTBumpFee was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		TxHash  string
+		FeeRate privatebtc.FeeRate
+	}{
+		Ctx:     ctx,
+		TxHash:  txHash,
+		FeeRate: feeRate,
+	}
+	mock.lockPSBTBumpFee.Lock()
+	mock.calls.PSBTBumpFee = append(mock.calls.PSBTBumpFee, callInfo)
+	mock.lockPSBTBumpFee.Unlock()
+	return mock.PSBTBumpFeeFunc(ctx, txHash, feeRate)
+}
+
+// PSBTBumpFeeCalls gets all the calls that were made to PSBTBumpFee.
+// Check the length with:
+//
+//	len(mockedRPCClient.PSBTBumpFeeCalls())
+func (mock *RPCClient) PSBTBumpFeeCalls() []struct {
+	Ctx     context.Context
+	TxHash  string
+	FeeRate privatebtc.FeeRate
+} {
+	var calls []struct {
+		Ctx     context.Context
+		TxHash  string
+		FeeRate privatebtc.FeeRate
+	}
+	mock.lockPSBTBumpFee.RLock()
+	calls = mock.calls.PSBTBumpFee
+	mock.lockPSBTBumpFee.RUnlock()
+	return calls
+}
+
+// RemovePeer calls RemovePeerFunc.
+func (mock *RPCClient) RemovePeer(ctx context.Context, peer privatebtc.Node) error {
+	if mock.RemovePeerFunc == nil {
+		panic("RPCClient.RemovePeerFunc: method is nil but RPCClient.RemovePeer was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Peer privatebtc.Node
+	}{
+		Ctx:  ctx,
+		Peer: peer,
+	}
+	mock.lockRemovePeer.Lock()
+	mock.calls.RemovePeer = append(mock.calls.RemovePeer, callInfo)
+	mock.lockRemovePeer.Unlock()
+	return mock.RemovePeerFunc(ctx, peer)
+}
+
+// RemovePeerCalls gets all the calls that were made to RemovePeer.
+// Check the length with:
+//
+//	len(mockedRPCClient.RemovePeerCalls())
+func (mock *RPCClient) RemovePeerCalls() []struct {
+	Ctx  context.Context
+	Peer privatebtc.Node
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Peer privatebtc.Node
+	}
+	mock.lockRemovePeer.RLock()
+	calls = mock.calls.RemovePeer
+	mock.lockRemovePeer.RUnlock()
+	return calls
+}
+
+// SendCustomTransaction calls SendCustomTransactionFunc.
+func (mock *RPCClient) SendCustomTransaction(ctx context.Context, inputs []privatebtc.TransactionVin, amounts map[string]privatebtc.Amount, lockTime uint32) (string, error) {
+	if mock.SendCustomTransactionFunc == nil {
+		panic("RPCClient.SendCustomTransactionFunc: method is nil but RPCClient.SendCustomTransaction was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Inputs   []privatebtc.TransactionVin
+		Amounts  map[string]privatebtc.Amount
+		LockTime uint32
+	}{
+		Ctx:      ctx,
+		Inputs:   inputs,
+		Amounts:  amounts,
+		LockTime: lockTime,
+	}
+	mock.lockSendCustomTransaction.Lock()
+	mock.calls.SendCustomTransaction = append(mock.calls.SendCustomTransaction, callInfo)
+	mock.lockSendCustomTransaction.Unlock()
+	return mock.SendCustomTransactionFunc(ctx, inputs, amounts, lockTime)
+}
+
+// SendCustomTransactionCalls gets all the calls that were made to SendCustomTransaction.
+// Check the length with:
+//
+//	len(mockedRPCClient.SendCustomTransactionCalls())
+func (mock *RPCClient) SendCustomTransactionCalls() []struct {
+	Ctx      context.Context
+	Inputs   []privatebtc.TransactionVin
+	Amounts  map[string]privatebtc.Amount
+	LockTime uint32
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Inputs   []privatebtc.TransactionVin
+		Amounts  map[string]privatebtc.Amount
+		LockTime uint32
+	}
+	mock.lockSendCustomTransaction.RLock()
+	calls = mock.calls.SendCustomTransaction
+	mock.lockSendCustomTransaction.RUnlock()
+	return calls
+}
+
+// SendRawTransaction calls SendRawTransactionFunc.
+func (mock *RPCClient) SendRawTransaction(ctx context.Context, txHex string) (string, error) {
+	if mock.SendRawTransactionFunc == nil {
+		panic("RPCClient.SendRawTransactionFunc: method is nil but RPCClient.SendRawTransaction was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		TxHex string
+	}{
+		Ctx:   ctx,
+		TxHex: txHex,
+	}
+	mock.lockSendRawTransaction.Lock()
+	mock.calls.SendRawTransaction = append(mock.calls.SendRawTransaction, callInfo)
+	mock.lockSendRawTransaction.Unlock()
+	return mock.SendRawTransactionFunc(ctx, txHex)
+}
+
+// SendRawTransactionCalls gets all the calls that were made to SendRawTransaction.
+// Check the length with:
+//
+//	len(mockedRPCClient.SendRawTransactionCalls())
+func (mock *RPCClient) SendRawTransactionCalls() []struct {
+	Ctx   context.Context
+	TxHex string
+} {
+	var calls []struct {
+		Ctx   context.Context
+		TxHex string
+	}
+	mock.lockSendRawTransaction.RLock()
+	calls = mock.calls.SendRawTransaction
+	mock.lockSendRawTransaction.RUnlock()
+	return calls
+}
+
+// SendToAddress calls SendToAddressFunc.
+func (mock *RPCClient) SendToAddress(ctx context.Context, address string, amount privatebtc.Amount, opts privatebtc.SendOptions) (string, error) {
+	if mock.SendToAddressFunc == nil {
+		panic("RPCClient.SendToAddressFunc: method is nil but RPCClient.SendToAddress was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Address string
+		Amount  privatebtc.Amount
+		Opts    privatebtc.SendOptions
+	}{
+		Ctx:     ctx,
+		Address: address,
+		Amount:  amount,
+		Opts:    opts,
+	}
+	mock.lockSendToAddress.Lock()
+	mock.calls.SendToAddress = append(mock.calls.SendToAddress, callInfo)
+	mock.lockSendToAddress.Unlock()
+	return mock.SendToAddressFunc(ctx, address, amount, opts)
 }
 
-// GetBlockCountCalls gets all the calls that were made to GetBlockCount.
+// SendToAddressCalls gets all the calls that were made to SendToAddress.
 // Check the length with:
 //
-//	len(mockedRPCClient.GetBlockCountCalls())
-func (mock *RPCClient) GetBlockCountCalls() []struct {
-	Ctx context.Context
+//	len(mockedRPCClient.SendToAddressCalls())
+func (mock *RPCClient) SendToAddressCalls() []struct {
+	Ctx     context.Context
+	Address string
+	Amount  privatebtc.Amount
+	Opts    privatebtc.SendOptions
 } {
 	var calls []struct {
-		Ctx context.Context
+		Ctx     context.Context
+		Address string
+		Amount  privatebtc.Amount
+		Opts    privatebtc.SendOptions
 	}
-	mock.lockGetBlockCount.RLock()
-	calls = mock.calls.GetBlockCount
-	mock.lockGetBlockCount.RUnlock()
+	mock.lockSendToAddress.RLock()
+	calls = mock.calls.SendToAddress
+	mock.lockSendToAddress.RUnlock()
 	return calls
 }
 
-// GetCoinbaseValue calls GetCoinbaseValueFunc.
-func (mock *RPCClient) GetCoinbaseValue(ctx context.Context) (int64, error) {
-	if mock.GetCoinbaseValueFunc == nil {
-		panic("RPCClient.GetCoinbaseValueFunc: method is nil but RPCClient.GetCoinbaseValue was just called")
+// SetMockTime calls SetMockTimeFunc.
+func (mock *RPCClient) SetMockTime(ctx context.Context, t time.Time) error {
+	if mock.SetMockTimeFunc == nil {
+		panic("RPCClient.SetMockTimeFunc: method is nil but RPCClient.SetMockTime was just called")
 	}
 	callInfo := struct {
 		Ctx context.Context
+		T   time.Time
 	}{
 		Ctx: ctx,
+		T:   t,
 	}
-	mock.lockGetCoinbaseValue.Lock()
-	mock.calls.GetCoinbaseValue = append(mock.calls.GetCoinbaseValue, callInfo)
-	mock.lockGetCoinbaseValue.Unlock()
-	return mock.GetCoinbaseValueFunc(ctx)
+	mock.lockSetMockTime.Lock()
+	mock.calls.SetMockTime = append(mock.calls.SetMockTime, callInfo)
+	mock.lockSetMockTime.Unlock()
+	return mock.SetMockTimeFunc(ctx, t)
 }
 
-// GetCoinbaseValueCalls gets all the calls that were made to GetCoinbaseValue.
+// SetMockTimeCalls gets all the calls that were made to SetMockTime.
 // Check the length with:
 //
-//	len(mockedRPCClient.GetCoinbaseValueCalls())
-func (mock *RPCClient) GetCoinbaseValueCalls() []struct {
+//	len(mockedRPCClient.SetMockTimeCalls())
+func (mock *RPCClient) SetMockTimeCalls() []struct {
 	Ctx context.Context
+	T   time.Time
 } {
 	var calls []struct {
 		Ctx context.Context
+		T   time.Time
 	}
-	mock.lockGetCoinbaseValue.RLock()
-	calls = mock.calls.GetCoinbaseValue
-	mock.lockGetCoinbaseValue.RUnlock()
+	mock.lockSetMockTime.RLock()
+	calls = mock.calls.SetMockTime
+	mock.lockSetMockTime.RUnlock()
 	return calls
 }
 
-// GetConnectionCount calls GetConnectionCountFunc.
-func (mock *RPCClient) GetConnectionCount(ctx context.Context) (int, error) {
-	if mock.GetConnectionCountFunc == nil {
-		panic("RPCClient.GetConnectionCountFunc: method is nil but RPCClient.GetConnectionCount was just called")
+// SetNetworkActive calls SetNetworkActiveFunc.
+func (mock *RPCClient) SetNetworkActive(ctx context.Context, active bool) error {
+	if mock.SetNetworkActiveFunc == nil {
+		panic("RPCClient.SetNetworkActiveFunc: method is nil but RPCClient.SetNetworkActive was just called")
 	}
 	callInfo := struct {
-		Ctx context.Context
+		Ctx    context.Context
+		Active bool
 	}{
-		Ctx: ctx,
+		Ctx:    ctx,
+		Active: active,
 	}
-	mock.lockGetConnectionCount.Lock()
-	mock.calls.GetConnectionCount = append(mock.calls.GetConnectionCount, callInfo)
-	mock.lockGetConnectionCount.Unlock()
-	return mock.GetConnectionCountFunc(ctx)
+	mock.lockSetNetworkActive.Lock()
+	mock.calls.SetNetworkActive = append(mock.calls.SetNetworkActive, callInfo)
+	mock.lockSetNetworkActive.Unlock()
+	return mock.SetNetworkActiveFunc(ctx, active)
 }
 
-// GetConnectionCountCalls gets all the calls that were made to GetConnectionCount.
+// SetNetworkActiveCalls gets all the calls that were made to SetNetworkActive.
 // Check the length with:
 //
-//	len(mockedRPCClient.GetConnectionCountCalls())
-func (mock *RPCClient) GetConnectionCountCalls() []struct {
-	Ctx context.Context
+//	len(mockedRPCClient.SetNetworkActiveCalls())
+func (mock *RPCClient) SetNetworkActiveCalls() []struct {
+	Ctx    context.Context
+	Active bool
 } {
 	var calls []struct {
-		Ctx context.Context
+		Ctx    context.Context
+		Active bool
 	}
-	mock.lockGetConnectionCount.RLock()
-	calls = mock.calls.GetConnectionCount
-	mock.lockGetConnectionCount.RUnlock()
+	mock.lockSetNetworkActive.RLock()
+	calls = mock.calls.SetNetworkActive
+	mock.lockSetNetworkActive.RUnlock()
 	return calls
 }
 
-// GetNewAddress calls GetNewAddressFunc.
-func (mock *RPCClient) GetNewAddress(ctx context.Context, label string) (string, error) {
-	if mock.GetNewAddressFunc == nil {
-		panic("RPCClient.GetNewAddressFunc: method is nil but RPCClient.GetNewAddress was just called")
+// SubmitPackage calls SubmitPackageFunc.
+func (mock *RPCClient) SubmitPackage(ctx context.Context, txHexes []string) (*privatebtc.PackageSubmitResult, error) {
+	if mock.SubmitPackageFunc == nil {
+		panic("RPCClient.SubmitPackageFunc: method is nil but RPCClient.SubmitPackage was just called")
 	}
 	callInfo := struct {
-		Ctx   context.Context
-		Label string
+		Ctx     context.Context
+		TxHexes []string
 	}{
-		Ctx:   ctx,
-		Label: label,
+		Ctx:     ctx,
+		TxHexes: txHexes,
 	}
-	mock.lockGetNewAddress.Lock()
-	mock.calls.GetNewAddress = append(mock.calls.GetNewAddress, callInfo)
-	mock.lockGetNewAddress.Unlock()
-	return mock.GetNewAddressFunc(ctx, label)
+	mock.lockSubmitPackage.Lock()
+	mock.calls.SubmitPackage = append(mock.calls.SubmitPackage, callInfo)
+	mock.lockSubmitPackage.Unlock()
+	return mock.SubmitPackageFunc(ctx, txHexes)
 }
 
-// GetNewAddressCalls gets all the calls that were made to GetNewAddress.
+// SubmitPackageCalls gets all the calls that were made to SubmitPackage.
 // Check the length with:
 //
-//	len(mockedRPCClient.GetNewAddressCalls())
-func (mock *RPCClient) GetNewAddressCalls() []struct {
-	Ctx   context.Context
-	Label string
+//	len(mockedRPCClient.SubmitPackageCalls())
+func (mock *RPCClient) SubmitPackageCalls() []struct {
+	Ctx     context.Context
+	TxHexes []string
 } {
 	var calls []struct {
-		Ctx   context.Context
-		Label string
+		Ctx     context.Context
+		TxHexes []string
 	}
-	mock.lockGetNewAddress.RLock()
-	calls = mock.calls.GetNewAddress
-	mock.lockGetNewAddress.RUnlock()
+	mock.lockSubmitPackage.RLock()
+	calls = mock.calls.SubmitPackage
+	mock.lockSubmitPackage.RUnlock()
 	return calls
 }
 
-// GetRawMempool calls GetRawMempoolFunc.
-func (mock *RPCClient) GetRawMempool(ctx context.Context) ([]string, error) {
-	if mock.GetRawMempoolFunc == nil {
-		panic("RPCClient.GetRawMempoolFunc: method is nil but RPCClient.GetRawMempool was just called")
+// TestMempoolAccept calls TestMempoolAcceptFunc.
+func (mock *RPCClient) TestMempoolAccept(ctx context.Context, txHexes []string) ([]privatebtc.MempoolAcceptResult, error) {
+	if mock.TestMempoolAcceptFunc == nil {
+		panic("RPCClient.TestMempoolAcceptFunc: method is nil but RPCClient.TestMempoolAccept was just called")
 	}
 	callInfo := struct {
-		Ctx context.Context
+		Ctx     context.Context
+		TxHexes []string
 	}{
-		Ctx: ctx,
+		Ctx:     ctx,
+		TxHexes: txHexes,
 	}
-	mock.lockGetRawMempool.Lock()
-	mock.calls.GetRawMempool = append(mock.calls.GetRawMempool, callInfo)
-	mock.lockGetRawMempool.Unlock()
-	return mock.GetRawMempoolFunc(ctx)
+	mock.lockTestMempoolAccept.Lock()
+	mock.calls.TestMempoolAccept = append(mock.calls.TestMempoolAccept, callInfo)
+	mock.lockTestMempoolAccept.Unlock()
+	return mock.TestMempoolAcceptFunc(ctx, txHexes)
 }
 
-// GetRawMempoolCalls gets all the calls that were made to GetRawMempool.
+// TestMempoolAcceptCalls gets all the calls that were made to TestMempoolAccept.
 // Check the length with:
 //
-//	len(mockedRPCClient.GetRawMempoolCalls())
-func (mock *RPCClient) GetRawMempoolCalls() []struct {
-	Ctx context.Context
+//	len(mockedRPCClient.TestMempoolAcceptCalls())
+func (mock *RPCClient) TestMempoolAcceptCalls() []struct {
+	Ctx     context.Context
+	TxHexes []string
 } {
 	var calls []struct {
-		Ctx context.Context
+		Ctx     context.Context
+		TxHexes []string
 	}
-	mock.lockGetRawMempool.RLock()
-	calls = mock.calls.GetRawMempool
-	mock.lockGetRawMempool.RUnlock()
+	mock.lockTestMempoolAccept.RLock()
+	calls = mock.calls.TestMempoolAccept
+	mock.lockTestMempoolAccept.RUnlock()
 	return calls
 }
 
-// GetTransaction calls GetTransactionFunc.
-func (mock *RPCClient) GetTransaction(ctx context.Context, txHash string) (*privatebtc.Transaction, error) {
-	if mock.GetTransactionFunc == nil {
-		panic("RPCClient.GetTransactionFunc: method is nil but RPCClient.GetTransaction was just called")
+// UnbanPeer calls UnbanPeerFunc.
+func (mock *RPCClient) UnbanPeer(ctx context.Context, peer privatebtc.Node) error {
+	if mock.UnbanPeerFunc == nil {
+		panic("RPCClient.UnbanPeerFunc: method is nil but RPCClient.UnbanPeer was just called")
 	}
 	callInfo := struct {
-		Ctx    context.Context
-		TxHash string
+		Ctx  context.Context
+		Peer privatebtc.Node
 	}{
-		Ctx:    ctx,
-		TxHash: txHash,
+		Ctx:  ctx,
+		Peer: peer,
 	}
-	mock.lockGetTransaction.Lock()
-	mock.calls.GetTransaction = append(mock.calls.GetTransaction, callInfo)
-	mock.lockGetTransaction.Unlock()
-	return mock.GetTransactionFunc(ctx, txHash)
+	mock.lockUnbanPeer.Lock()
+	mock.calls.UnbanPeer = append(mock.calls.UnbanPeer, callInfo)
+	mock.lockUnbanPeer.Unlock()
+	return mock.UnbanPeerFunc(ctx, peer)
 }
 
-// GetTransactionCalls gets all the calls that were made to GetTransaction.
+// UnbanPeerCalls gets all the calls that were made to UnbanPeer.
 // Check the length with:
 //
-//	len(mockedRPCClient.GetTransactionCalls())
-func (mock *RPCClient) GetTransactionCalls() []struct {
-	Ctx    context.Context
-	TxHash string
+//	len(mockedRPCClient.UnbanPeerCalls())
+func (mock *RPCClient) UnbanPeerCalls() []struct {
+	Ctx  context.Context
+	Peer privatebtc.Node
 } {
 	var calls []struct {
-		Ctx    context.Context
-		TxHash string
+		Ctx  context.Context
+		Peer privatebtc.Node
 	}
-	mock.lockGetTransaction.RLock()
-	calls = mock.calls.GetTransaction
-	mock.lockGetTransaction.RUnlock()
+	mock.lockUnbanPeer.RLock()
+	calls = mock.calls.UnbanPeer
+	mock.lockUnbanPeer.RUnlock()
 	return calls
 }
 
-// GetTransactionOutputs calls GetTransactionOutputsFunc.
-func (mock *RPCClient) GetTransactionOutputs(ctx context.Context, txHash string) ([]privatebtc.MempoolTransactionOutput, error) {
-	if mock.GetTransactionOutputsFunc == nil {
-		panic("RPCClient.GetTransactionOutputsFunc: method is nil but RPCClient.GetTransactionOutputs was just called")
+// UnloadWallet calls UnloadWalletFunc.
+func (mock *RPCClient) UnloadWallet(ctx context.Context, walletName string) error {
+	if mock.UnloadWalletFunc == nil {
+		panic("RPCClient.UnloadWalletFunc: method is nil but RPCClient.UnloadWallet was just called")
 	}
 	callInfo := struct {
-		Ctx    context.Context
-		TxHash string
+		Ctx        context.Context
+		WalletName string
 	}{
-		Ctx:    ctx,
-		TxHash: txHash,
+		Ctx:        ctx,
+		WalletName: walletName,
 	}
-	mock.lockGetTransactionOutputs.Lock()
-	mock.calls.GetTransactionOutputs = append(mock.calls.GetTransactionOutputs, callInfo)
-	mock.lockGetTransactionOutputs.Unlock()
-	return mock.GetTransactionOutputsFunc(ctx, txHash)
+	mock.lockUnloadWallet.Lock()
+	mock.calls.UnloadWallet = append(mock.calls.UnloadWallet, callInfo)
+	mock.lockUnloadWallet.Unlock()
+	return mock.UnloadWalletFunc(ctx, walletName)
 }
 
-// GetTransactionOutputsCalls gets all the calls that were made to GetTransactionOutputs.
+// UnloadWalletCalls gets all the calls that were made to UnloadWallet.
 // Check the length with:
 //
-//	len(mockedRPCClient.GetTransactionOutputsCalls())
-func (mock *RPCClient) GetTransactionOutputsCalls() []struct {
-	Ctx    context.Context
-	TxHash string
+//	len(mockedRPCClient.UnloadWalletCalls())
+func (mock *RPCClient) UnloadWalletCalls() []struct {
+	Ctx        context.Context
+	WalletName string
 } {
 	var calls []struct {
-		Ctx    context.Context
-		TxHash string
+		Ctx        context.Context
+		WalletName string
 	}
-	mock.lockGetTransactionOutputs.RLock()
-	calls = mock.calls.GetTransactionOutputs
-	mock.lockGetTransactionOutputs.RUnlock()
+	mock.lockUnloadWallet.RLock()
+	calls = mock.calls.UnloadWallet
+	mock.lockUnloadWallet.RUnlock()
 	return calls
 }
 
-// ListAddresses calls ListAddressesFunc.
-func (mock *RPCClient) ListAddresses(ctx context.Context) ([]string, error) {
-	if mock.ListAddressesFunc == nil {
-		panic("RPCClient.ListAddressesFunc: method is nil but RPCClient.ListAddresses was just called")
+// UnlockUnspent calls UnlockUnspentFunc.
+func (mock *RPCClient) UnlockUnspent(ctx context.Context, outpoints []privatebtc.TransactionVin) error {
+	if mock.UnlockUnspentFunc == nil {
+		panic("RPCClient.UnlockUnspentFunc: method is nil but RPCClient.UnlockUnspent was just called")
 	}
 	callInfo := struct {
-		Ctx context.Context
+		Ctx       context.Context
+		Outpoints []privatebtc.TransactionVin
 	}{
-		Ctx: ctx,
+		Ctx:       ctx,
+		Outpoints: outpoints,
 	}
-	mock.lockListAddresses.Lock()
-	mock.calls.ListAddresses = append(mock.calls.ListAddresses, callInfo)
-	mock.lockListAddresses.Unlock()
-	return mock.ListAddressesFunc(ctx)
+	mock.lockUnlockUnspent.Lock()
+	mock.calls.UnlockUnspent = append(mock.calls.UnlockUnspent, callInfo)
+	mock.lockUnlockUnspent.Unlock()
+	return mock.UnlockUnspentFunc(ctx, outpoints)
 }
 
-// ListAddressesCalls gets all the calls that were made to ListAddresses.
+// UnlockUnspentCalls gets all the calls that were made to UnlockUnspent.
 // Check the length with:
 //
-//	len(mockedRPCClient.ListAddressesCalls())
-func (mock *RPCClient) ListAddressesCalls() []struct {
-	Ctx context.Context
+//	len(mockedRPCClient.UnlockUnspentCalls())
+func (mock *RPCClient) UnlockUnspentCalls() []struct {
+	Ctx       context.Context
+	Outpoints []privatebtc.TransactionVin
 } {
 	var calls []struct {
-		Ctx context.Context
+		Ctx       context.Context
+		Outpoints []privatebtc.TransactionVin
 	}
-	mock.lockListAddresses.RLock()
-	calls = mock.calls.ListAddresses
-	mock.lockListAddresses.RUnlock()
+	mock.lockUnlockUnspent.RLock()
+	calls = mock.calls.UnlockUnspent
+	mock.lockUnlockUnspent.RUnlock()
 	return calls
 }
 
-// RemovePeer calls RemovePeerFunc.
-func (mock *RPCClient) RemovePeer(ctx context.Context, peer privatebtc.Node) error {
-	if mock.RemovePeerFunc == nil {
-		panic("RPCClient.RemovePeerFunc: method is nil but RPCClient.RemovePeer was just called")
+// Wallet calls WalletFunc.
+func (mock *RPCClient) Wallet(walletName string) (privatebtc.RPCClient, error) {
+	if mock.WalletFunc == nil {
+		panic("RPCClient.WalletFunc: method is nil but RPCClient.Wallet was just called")
 	}
 	callInfo := struct {
-		Ctx  context.Context
-		Peer privatebtc.Node
+		WalletName string
 	}{
-		Ctx:  ctx,
-		Peer: peer,
+		WalletName: walletName,
 	}
-	mock.lockRemovePeer.Lock()
-	mock.calls.RemovePeer = append(mock.calls.RemovePeer, callInfo)
-	mock.lockRemovePeer.Unlock()
-	return mock.RemovePeerFunc(ctx, peer)
+	mock.lockWallet.Lock()
+	mock.calls.Wallet = append(mock.calls.Wallet, callInfo)
+	mock.lockWallet.Unlock()
+	return mock.WalletFunc(walletName)
 }
 
-// RemovePeerCalls gets all the calls that were made to RemovePeer.
+// WalletCalls gets all the calls that were made to Wallet.
 // Check the length with:
 //
-//	len(mockedRPCClient.RemovePeerCalls())
-func (mock *RPCClient) RemovePeerCalls() []struct {
-	Ctx  context.Context
-	Peer privatebtc.Node
+//	len(mockedRPCClient.WalletCalls())
+func (mock *RPCClient) WalletCalls() []struct {
+	WalletName string
 } {
 	var calls []struct {
-		Ctx  context.Context
-		Peer privatebtc.Node
+		WalletName string
 	}
-	mock.lockRemovePeer.RLock()
-	calls = mock.calls.RemovePeer
-	mock.lockRemovePeer.RUnlock()
+	mock.lockWallet.RLock()
+	calls = mock.calls.Wallet
+	mock.lockWallet.RUnlock()
 	return calls
 }
 
-// SendCustomTransaction calls SendCustomTransactionFunc.
-func (mock *RPCClient) SendCustomTransaction(ctx context.Context, inputs []privatebtc.TransactionVin, amounts map[string]float64) (string, error) {
-	if mock.SendCustomTransactionFunc == nil {
-		panic("RPCClient.SendCustomTransactionFunc: method is nil but RPCClient.SendCustomTransaction was just called")
+// WalletCreateFundedPSBT calls WalletCreateFundedPSBTFunc.
+func (mock *RPCClient) WalletCreateFundedPSBT(ctx context.Context, inputs []privatebtc.TransactionVin, amounts map[string]privatebtc.Amount, opts privatebtc.SendOptions) (*privatebtc.FundedPSBT, error) {
+	if mock.WalletCreateFundedPSBTFunc == nil {
+		panic("RPCClient.WalletCreateFundedPSBTFunc: method is nil but RPCClient.WalletCreateFundedPSBT was just called")
 	}
 	callInfo := struct {
 		Ctx     context.Context
 		Inputs  []privatebtc.TransactionVin
-		Amounts map[string]float64
+		Amounts map[string]privatebtc.Amount
+		Opts    privatebtc.SendOptions
 	}{
 		Ctx:     ctx,
 		Inputs:  inputs,
 		Amounts: amounts,
+		Opts:    opts,
 	}
-	mock.lockSendCustomTransaction.Lock()
-	mock.calls.SendCustomTransaction = append(mock.calls.SendCustomTransaction, callInfo)
-	mock.lockSendCustomTransaction.Unlock()
-	return mock.SendCustomTransactionFunc(ctx, inputs, amounts)
+	mock.lockWalletCreateFundedPSBT.Lock()
+	mock.calls.WalletCreateFundedPSBT = append(mock.calls.WalletCreateFundedPSBT, callInfo)
+	mock.lockWalletCreateFundedPSBT.Unlock()
+	return mock.WalletCreateFundedPSBTFunc(ctx, inputs, amounts, opts)
 }
 
-// SendCustomTransactionCalls gets all the calls that were made to SendCustomTransaction.
+// WalletCreateFundedPSBTCalls gets all the calls that were made to WalletCreateFundedPSBT.
 // Check the length with:
 //
-//	len(mockedRPCClient.SendCustomTransactionCalls())
-func (mock *RPCClient) SendCustomTransactionCalls() []struct {
+//	len(mockedRPCClient.WalletCreateFundedPSBTCalls())
+func (mock *RPCClient) WalletCreateFundedPSBTCalls() []struct {
 	Ctx     context.Context
 	Inputs  []privatebtc.TransactionVin
-	Amounts map[string]float64
+	Amounts map[string]privatebtc.Amount
+	Opts    privatebtc.SendOptions
 } {
 	var calls []struct {
 		Ctx     context.Context
 		Inputs  []privatebtc.TransactionVin
-		Amounts map[string]float64
+		Amounts map[string]privatebtc.Amount
+		Opts    privatebtc.SendOptions
 	}
-	mock.lockSendCustomTransaction.RLock()
-	calls = mock.calls.SendCustomTransaction
-	mock.lockSendCustomTransaction.RUnlock()
+	mock.lockWalletCreateFundedPSBT.RLock()
+	calls = mock.calls.WalletCreateFundedPSBT
+	mock.lockWalletCreateFundedPSBT.RUnlock()
 	return calls
 }
 
-// SendToAddress calls SendToAddressFunc.
-func (mock *RPCClient) SendToAddress(ctx context.Context, address string, amount float64) (string, error) {
-	if mock.SendToAddressFunc == nil {
-		panic("RPCClient.SendToAddressFunc: method is nil but RPCClient.SendToAddress was just called")
+// WalletProcessPSBT calls WalletProcessPSBTFunc.
+func (mock *RPCClient) WalletProcessPSBT(ctx context.Context, psbt string, sign bool) (*privatebtc.ProcessedPSBT, error) {
+	if mock.WalletProcessPSBTFunc == nil {
+		panic("RPCClient.WalletProcessPSBTFunc: method is nil but RPCClient.WalletProcessPSBT was just called")
 	}
 	callInfo := struct {
-		Ctx     context.Context
-		Address string
-		Amount  float64
+		Ctx  context.Context
+		Psbt string
+		Sign bool
 	}{
-		Ctx:     ctx,
-		Address: address,
-		Amount:  amount,
+		Ctx:  ctx,
+		Psbt: psbt,
+		Sign: sign,
 	}
-	mock.lockSendToAddress.Lock()
-	mock.calls.SendToAddress = append(mock.calls.SendToAddress, callInfo)
-	mock.lockSendToAddress.Unlock()
-	return mock.SendToAddressFunc(ctx, address, amount)
+	mock.lockWalletProcessPSBT.Lock()
+	mock.calls.WalletProcessPSBT = append(mock.calls.WalletProcessPSBT, callInfo)
+	mock.lockWalletProcessPSBT.Unlock()
+	return mock.WalletProcessPSBTFunc(ctx, psbt, sign)
 }
 
-// SendToAddressCalls gets all the calls that were made to SendToAddress.
+// WalletProcessPSBTCalls gets all the calls that were made to WalletProcessPSBT.
 // Check the length with:
 //
-//	len(mockedRPCClient.SendToAddressCalls())
-func (mock *RPCClient) SendToAddressCalls() []struct {
-	Ctx     context.Context
-	Address string
-	Amount  float64
+//	len(mockedRPCClient.WalletProcessPSBTCalls())
+func (mock *RPCClient) WalletProcessPSBTCalls() []struct {
+	Ctx  context.Context
+	Psbt string
+	Sign bool
 } {
 	var calls []struct {
-		Ctx     context.Context
-		Address string
-		Amount  float64
+		Ctx  context.Context
+		Psbt string
+		Sign bool
 	}
-	mock.lockSendToAddress.RLock()
-	calls = mock.calls.SendToAddress
-	mock.lockSendToAddress.RUnlock()
+	mock.lockWalletProcessPSBT.RLock()
+	calls = mock.calls.WalletProcessPSBT
+	mock.lockWalletProcessPSBT.RUnlock()
 	return calls
 }
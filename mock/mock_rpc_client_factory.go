@@ -4,6 +4,7 @@
 package mock
 
 import (
+	"context"
 	"github.com/adrianbrad/privatebtc"
 	"sync"
 )
@@ -18,7 +19,7 @@ var _ privatebtc.RPCClientFactory = &RPCClientFactory{}
 //
 //		// make and configure a mocked privatebtc.RPCClientFactory
 //		mockedRPCClientFactory := &RPCClientFactory{
-//			NewRPCClientFunc: func(hostRPCPort string, rpcUser string, rpcPass string) (privatebtc.RPCClient, error) {
+//			NewRPCClientFunc: func(ctx context.Context, hostRPCPort string, rpcUser string, rpcPass string) (privatebtc.RPCClient, error) {
 //				panic("mock out the NewRPCClient method")
 //			},
 //		}
@@ -29,12 +30,14 @@ var _ privatebtc.RPCClientFactory = &RPCClientFactory{}
 //	}
 type RPCClientFactory struct {
 	// NewRPCClientFunc mocks the NewRPCClient method.
-	NewRPCClientFunc func(hostRPCPort string, rpcUser string, rpcPass string) (privatebtc.RPCClient, error)
+	NewRPCClientFunc func(ctx context.Context, hostRPCPort string, rpcUser string, rpcPass string) (privatebtc.RPCClient, error)
 
 	// calls tracks calls to the methods.
 	calls struct {
 		// NewRPCClient holds details about calls to the NewRPCClient method.
 		NewRPCClient []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
 			// HostRPCPort is the hostRPCPort argument value.
 			HostRPCPort string
 			// RpcUser is the rpcUser argument value.
@@ -47,15 +50,17 @@ type RPCClientFactory struct {
 }
 
 // NewRPCClient calls NewRPCClientFunc.
-func (mock *RPCClientFactory) NewRPCClient(hostRPCPort string, rpcUser string, rpcPass string) (privatebtc.RPCClient, error) {
+func (mock *RPCClientFactory) NewRPCClient(ctx context.Context, hostRPCPort string, rpcUser string, rpcPass string) (privatebtc.RPCClient, error) {
 	if mock.NewRPCClientFunc == nil {
 		panic("RPCClientFactory.NewRPCClientFunc: method is nil but RPCClientFactory.NewRPCClient was just called")
 	}
 	callInfo := struct {
+		Ctx         context.Context
 		HostRPCPort string
 		RpcUser     string
 		RpcPass     string
 	}{
+		Ctx:         ctx,
 		HostRPCPort: hostRPCPort,
 		RpcUser:     rpcUser,
 		RpcPass:     rpcPass,
@@ -63,7 +68,7 @@ func (mock *RPCClientFactory) NewRPCClient(hostRPCPort string, rpcUser string, r
 	mock.lockNewRPCClient.Lock()
 	mock.calls.NewRPCClient = append(mock.calls.NewRPCClient, callInfo)
 	mock.lockNewRPCClient.Unlock()
-	return mock.NewRPCClientFunc(hostRPCPort, rpcUser, rpcPass)
+	return mock.NewRPCClientFunc(ctx, hostRPCPort, rpcUser, rpcPass)
 }
 
 // NewRPCClientCalls gets all the calls that were made to NewRPCClient.
@@ -71,11 +76,13 @@ func (mock *RPCClientFactory) NewRPCClient(hostRPCPort string, rpcUser string, r
 //
 //	len(mockedRPCClientFactory.NewRPCClientCalls())
 func (mock *RPCClientFactory) NewRPCClientCalls() []struct {
+	Ctx         context.Context
 	HostRPCPort string
 	RpcUser     string
 	RpcPass     string
 } {
 	var calls []struct {
+		Ctx         context.Context
 		HostRPCPort string
 		RpcUser     string
 		RpcPass     string
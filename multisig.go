@@ -0,0 +1,353 @@
+package privatebtc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"golang.org/x/exp/slices"
+	"golang.org/x/sync/errgroup"
+)
+
+// MultisigWallet is an m-of-n multisig watch-only descriptor wallet loaded on a
+// coordinator node, whose keys live in the wallets of the signer nodes.
+// The wallet addresses are P2WSH sortedmulti addresses derived from the BIP 84
+// account keys of the signer wallets.
+type MultisigWallet struct {
+	name              string
+	required          int
+	coordinator       Node
+	signers           Nodes
+	receiveDescriptor string
+	changeDescriptor  string
+	rpcClient         RPCClient
+	signerClients     []psbtSigner
+}
+
+// CreateMultisigWallet creates a multisig watch-only descriptor wallet with the given
+// name on the coordinator node, requiring the given number of signatures from the
+// wallets of the signer nodes. The nodes are identified by their IDs.
+// Every signer node has to be configured with a wallet, the coordinator node can be
+// one of the signers.
+// Once the multisig wallet is created the coordinator node has more than one wallet
+// loaded, so its wallet RPCs have to be sent through a client scoped with
+// Node.WalletClient or Node.Wallet.
+// nolint: funlen
+func (n *PrivateNetwork) CreateMultisigWallet(
+	ctx context.Context,
+	walletName string,
+	required int,
+	coordinatorID int,
+	signerIDs ...int,
+) (*MultisigWallet, error) {
+	if required < 1 || required > len(signerIDs) {
+		return nil, fmt.Errorf(
+			"%d of %d: %w",
+			required,
+			len(signerIDs),
+			ErrInvalidMultisigThreshold,
+		)
+	}
+
+	coordinator, err := n.nodeByID(coordinatorID)
+	if err != nil {
+		return nil, fmt.Errorf("coordinator: %w", err)
+	}
+
+	signers := make(Nodes, len(signerIDs))
+	signerClients := make([]psbtSigner, len(signerIDs))
+
+	for i, id := range signerIDs {
+		if signers[i], err = n.nodeByID(id); err != nil {
+			return nil, fmt.Errorf("signer: %w", err)
+		}
+
+		rpcClient, err := n.nodeWalletClient(signers[i])
+		if err != nil {
+			return nil, fmt.Errorf("signer %d wallet client: %w", id, err)
+		}
+
+		signerClients[i] = psbtSigner{nodeID: id, rpcClient: rpcClient}
+	}
+
+	n.logger.Info(
+		"🔐⌛ Creating multisig wallet",
+		"wallet", walletName,
+		"required", required,
+		"signers", len(signers),
+	)
+
+	receiveKeys := make([]string, len(signers))
+	changeKeys := make([]string, len(signers))
+
+	eg, egCtx := errgroup.WithContext(ctx)
+
+	for i := range signerClients {
+		i := i
+
+		eg.Go(func() error {
+			receiveKey, changeKey, err := signerKeys(egCtx, signerClients[i].rpcClient)
+			if err != nil {
+				return fmt.Errorf("signer %d keys: %w", signerClients[i].nodeID, err)
+			}
+
+			receiveKeys[i], changeKeys[i] = receiveKey, changeKey
+
+			return nil
+		})
+	}
+
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+
+	receiveDescriptor, err := multisigDescriptor(ctx, coordinator.RPCClient(), required, receiveKeys)
+	if err != nil {
+		return nil, fmt.Errorf("receive descriptor: %w", err)
+	}
+
+	changeDescriptor, err := multisigDescriptor(ctx, coordinator.RPCClient(), required, changeKeys)
+	if err != nil {
+		return nil, fmt.Errorf("change descriptor: %w", err)
+	}
+
+	if err := coordinator.RPCClient().CreateWatchOnlyWallet(ctx, walletName); err != nil {
+		return nil, fmt.Errorf("create watch only wallet: %w", err)
+	}
+
+	walletClient, err := coordinator.RPCClient().Wallet(walletName)
+	if err != nil {
+		return nil, fmt.Errorf("wallet client: %w", err)
+	}
+
+	if err := walletClient.ImportDescriptors(ctx, []ImportDescriptorRequest{
+		{Desc: receiveDescriptor, Active: true, Internal: false},
+		{Desc: changeDescriptor, Active: true, Internal: true},
+	}); err != nil {
+		return nil, fmt.Errorf("import descriptors: %w", err)
+	}
+
+	n.logger.Info("🔐✅ Successfully created multisig wallet", "wallet", walletName)
+
+	return &MultisigWallet{
+		name:              walletName,
+		required:          required,
+		coordinator:       coordinator,
+		signers:           signers,
+		receiveDescriptor: receiveDescriptor,
+		changeDescriptor:  changeDescriptor,
+		rpcClient:         walletClient,
+		signerClients:     signerClients,
+	}, nil
+}
+
+// nodeByID returns the node with the given ID.
+func (n *PrivateNetwork) nodeByID(id int) (Node, error) {
+	nodes := n.Nodes()
+
+	i := slices.IndexFunc(nodes, func(node Node) bool { return node.id == id })
+	if i == -1 {
+		return Node{}, fmt.Errorf("node %d: %w", id, ErrNodeNotFound)
+	}
+
+	return nodes[i], nil
+}
+
+// nodeWalletClient returns the RPC client of the node scoped to the node wallet.
+func (n *PrivateNetwork) nodeWalletClient(node Node) (RPCClient, error) {
+	n.mu.RLock()
+	walletName := n.nodeWallets[node.id]
+	n.mu.RUnlock()
+
+	if walletName == nil {
+		return nil, fmt.Errorf("node %d: %w", node.id, ErrNodeWithoutWallet)
+	}
+
+	return node.RPCClient().Wallet(*walletName)
+}
+
+// signerKeys returns the receive and change extended keys, along with their origin
+// and derivation path, of the active wpkh descriptors of the given wallet,
+// e.g. [d34db33f/84h/1h/0h]tpub.../0/*.
+func signerKeys(ctx context.Context, rpcClient RPCClient) (receiveKey, changeKey string, _ error) {
+	descriptors, err := rpcClient.ListDescriptors(ctx, false)
+	if err != nil {
+		return "", "", fmt.Errorf("list descriptors: %w", err)
+	}
+
+	for _, d := range descriptors {
+		if !d.Active {
+			continue
+		}
+
+		desc, _, _ := strings.Cut(d.Desc, "#")
+
+		key, ok := strings.CutPrefix(desc, "wpkh(")
+		if !ok {
+			continue
+		}
+
+		key = strings.TrimSuffix(key, ")")
+
+		if d.Internal {
+			changeKey = key
+		} else {
+			receiveKey = key
+		}
+	}
+
+	if receiveKey == "" || changeKey == "" {
+		return "", "", ErrSignerDescriptorNotFound
+	}
+
+	return receiveKey, changeKey, nil
+}
+
+// multisigDescriptor returns the P2WSH sortedmulti descriptor of the given keys,
+// including its checksum.
+func multisigDescriptor(
+	ctx context.Context,
+	rpcClient RPCClient,
+	required int,
+	keys []string,
+) (string, error) {
+	desc := fmt.Sprintf("wsh(sortedmulti(%d,%s))", required, strings.Join(keys, ","))
+
+	info, err := rpcClient.GetDescriptorInfo(ctx, desc)
+	if err != nil {
+		return "", fmt.Errorf("get descriptor info: %w", err)
+	}
+
+	return desc + "#" + info.Checksum, nil
+}
+
+// Name returns the name of the multisig wallet.
+func (w *MultisigWallet) Name() string {
+	return w.name
+}
+
+// Required returns the number of signatures required to spend from the wallet.
+func (w *MultisigWallet) Required() int {
+	return w.required
+}
+
+// Coordinator returns the node the multisig wallet is loaded on.
+func (w *MultisigWallet) Coordinator() Node {
+	return w.coordinator
+}
+
+// Signers returns the nodes whose wallets hold the multisig keys.
+func (w *MultisigWallet) Signers() Nodes {
+	return slices.Clone(w.signers)
+}
+
+// ReceiveDescriptor returns the descriptor of the wallet receive addresses.
+func (w *MultisigWallet) ReceiveDescriptor() string {
+	return w.receiveDescriptor
+}
+
+// ChangeDescriptor returns the descriptor of the wallet change addresses.
+func (w *MultisigWallet) ChangeDescriptor() string {
+	return w.changeDescriptor
+}
+
+// RPCClient returns the RPC client of the coordinator node scoped to the multisig wallet.
+func (w *MultisigWallet) RPCClient() RPCClient {
+	return w.rpcClient
+}
+
+// Fund sends the given amount from the wallet of the given node to a new address
+// of the multisig wallet, the transaction has to be mined to be spendable.
+func (w *MultisigWallet) Fund(ctx context.Context, from Node, amount Amount) (txHash string, _ error) {
+	addr, err := w.rpcClient.GetNewAddress(ctx, "fund")
+	if err != nil {
+		return "", fmt.Errorf("get new address: %w", err)
+	}
+
+	fromClient, err := from.pn.nodeWalletClient(from)
+	if err != nil {
+		return "", fmt.Errorf("funder wallet client: %w", err)
+	}
+
+	txHash, err = fromClient.SendToAddress(ctx, addr, amount, SendOptions{})
+	if err != nil {
+		return "", fmt.Errorf("send to address: %w", err)
+	}
+
+	return txHash, nil
+}
+
+// Send pays the given amounts from the multisig wallet. The transaction is funded
+// by the multisig wallet, signed by the wallets of the signer nodes with the given
+// IDs, or by every signer if none are given, and broadcast through the coordinator node.
+func (w *MultisigWallet) Send(
+	ctx context.Context,
+	amounts map[string]Amount,
+	signerIDs ...int,
+) (txHash string, _ error) {
+	signers, err := w.psbtSigners(signerIDs)
+	if err != nil {
+		return "", err
+	}
+
+	funded, err := w.rpcClient.WalletCreateFundedPSBT(ctx, nil, amounts, SendOptions{})
+	if err != nil {
+		return "", fmt.Errorf("wallet create funded psbt: %w", err)
+	}
+
+	combined, err := signPSBT(ctx, signers, w.rpcClient, funded.PSBT)
+	if err != nil {
+		return "", fmt.Errorf("sign psbt: %w", err)
+	}
+
+	return finalizeAndBroadcastPSBT(ctx, w.rpcClient, combined)
+}
+
+// BumpFee replaces the given multisig wallet transaction with a transaction paying
+// the given fee rate. The replacement is signed by the wallets of the signer nodes
+// with the given IDs, or by every signer if none are given, and broadcast through
+// the coordinator node.
+func (w *MultisigWallet) BumpFee(
+	ctx context.Context,
+	txID string,
+	targetFeeRate FeeRate,
+	signerIDs ...int,
+) (txHash string, _ error) {
+	signers, err := w.psbtSigners(signerIDs)
+	if err != nil {
+		return "", err
+	}
+
+	bumped, err := w.rpcClient.PSBTBumpFee(ctx, txID, targetFeeRate)
+	if err != nil {
+		return "", fmt.Errorf("psbt bump fee: %w", err)
+	}
+
+	combined, err := signPSBT(ctx, signers, w.rpcClient, bumped.PSBT)
+	if err != nil {
+		return "", fmt.Errorf("sign psbt: %w", err)
+	}
+
+	return finalizeAndBroadcastPSBT(ctx, w.rpcClient, combined)
+}
+
+// psbtSigners returns the signers with the given node IDs, or every signer if
+// no IDs are given.
+func (w *MultisigWallet) psbtSigners(signerIDs []int) ([]psbtSigner, error) {
+	if len(signerIDs) == 0 {
+		return w.signerClients, nil
+	}
+
+	signers := make([]psbtSigner, len(signerIDs))
+
+	for i, id := range signerIDs {
+		j := slices.IndexFunc(w.signerClients, func(s psbtSigner) bool { return s.nodeID == id })
+		if j == -1 {
+			return nil, fmt.Errorf("signer %d: %w", id, ErrNodeNotFound)
+		}
+
+		signers[i] = w.signerClients[j]
+	}
+
+	return signers, nil
+}
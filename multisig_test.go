@@ -0,0 +1,274 @@
+package privatebtc_test
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/adrianbrad/privatebtc"
+	"github.com/adrianbrad/privatebtc/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// multisigWallets records the wallet RPCs sent to the mock nodes, every node
+// wallet has a wpkh receive and change descriptor derived from the node ID.
+type multisigWallets struct {
+	mu       sync.Mutex
+	created  map[int]string
+	imported map[int][]privatebtc.ImportDescriptorRequest
+	combined []string
+	sent     []string
+
+	// withoutDescriptors is the ID of a node whose wallet has no wpkh descriptors.
+	withoutDescriptors int
+}
+
+func newMultisigWallets() *multisigWallets {
+	return &multisigWallets{
+		created:            map[int]string{},
+		imported:           map[int][]privatebtc.ImportDescriptorRequest{},
+		withoutDescriptors: -1,
+	}
+}
+
+// rpcClient returns a mock client which serves the wallet descriptors of the
+// node and records the multisig wallets created on it.
+func (w *multisigWallets) rpcClient(id int) *mock.RPCClient {
+	key := "[fp" + strconv.Itoa(id) + "/84h/1h/0h]tpub" + strconv.Itoa(id)
+
+	c := &mock.RPCClient{
+		ListDescriptorsFunc: func(_ context.Context, private bool) ([]privatebtc.Descriptor, error) {
+			if private || id == w.withoutDescriptors {
+				return nil, nil
+			}
+
+			return []privatebtc.Descriptor{
+				{Desc: "pkh(" + key + "/0/*)#pkh", Active: false},
+				{Desc: "wpkh(" + key + "/0/*)#recv", Active: true},
+				{Desc: "wpkh(" + key + "/1/*)#chng", Active: true, Internal: true},
+			}, nil
+		},
+		GetDescriptorInfoFunc: func(context.Context, string) (*privatebtc.DescriptorInfo, error) {
+			return &privatebtc.DescriptorInfo{Checksum: "chk"}, nil
+		},
+		CreateWatchOnlyWalletFunc: func(_ context.Context, walletName string) error {
+			w.mu.Lock()
+			defer w.mu.Unlock()
+
+			w.created[id] = walletName
+
+			return nil
+		},
+		ImportDescriptorsFunc: func(_ context.Context, requests []privatebtc.ImportDescriptorRequest) error {
+			w.mu.Lock()
+			defer w.mu.Unlock()
+
+			w.imported[id] = requests
+
+			return nil
+		},
+	}
+
+	c.WalletFunc = func(string) (privatebtc.RPCClient, error) {
+		return c, nil
+	}
+
+	return c
+}
+
+// sendingRPCClient extends the rpcClient mock with the PSBT calls of a multisig
+// spend, recording the combined PSBTs and the sent transactions.
+func (w *multisigWallets) sendingRPCClient(id int) *mock.RPCClient {
+	c := w.rpcClient(id)
+
+	c.WalletCreateFundedPSBTFunc = func(
+		context.Context,
+		[]privatebtc.TransactionVin,
+		map[string]privatebtc.Amount,
+		privatebtc.SendOptions,
+	) (*privatebtc.FundedPSBT, error) {
+		return &privatebtc.FundedPSBT{PSBT: "psbt"}, nil
+	}
+
+	c.WalletProcessPSBTFunc = func(_ context.Context, psbt string, _ bool) (*privatebtc.ProcessedPSBT, error) {
+		return &privatebtc.ProcessedPSBT{PSBT: psbt + "_signed_" + strconv.Itoa(id)}, nil
+	}
+
+	c.CombinePSBTFunc = func(_ context.Context, psbts []string) (string, error) {
+		w.mu.Lock()
+		defer w.mu.Unlock()
+
+		w.combined = psbts
+
+		return "combined", nil
+	}
+
+	c.FinalizePSBTFunc = func(context.Context, string) (*privatebtc.FinalizedPSBT, error) {
+		return &privatebtc.FinalizedPSBT{Hex: "txhex", Complete: true}, nil
+	}
+
+	c.PSBTBumpFeeFunc = func(context.Context, string, privatebtc.FeeRate) (*privatebtc.BumpedTransaction, error) {
+		return &privatebtc.BumpedTransaction{PSBT: "bumped"}, nil
+	}
+
+	c.SendRawTransactionFunc = func(_ context.Context, txHex string) (string, error) {
+		w.mu.Lock()
+		defer w.mu.Unlock()
+
+		w.sent = append(w.sent, txHex)
+
+		return "txhash", nil
+	}
+
+	return c
+}
+
+func TestPrivateNetworkCreateMultisigWallet(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		opts               []privatebtc.Option
+		required           int
+		signers            []int
+		withoutDescriptors int
+		expectedReceive    string
+		expectedChange     string
+		expectedError      error
+	}{
+		"TwoOfThree": {
+			opts:               []privatebtc.Option{privatebtc.WithWallet("test")},
+			required:           2,
+			signers:            []int{0, 1, 2},
+			withoutDescriptors: -1,
+			expectedReceive: "wsh(sortedmulti(2,[fp0/84h/1h/0h]tpub0/0/*," +
+				"[fp1/84h/1h/0h]tpub1/0/*,[fp2/84h/1h/0h]tpub2/0/*))#chk",
+			expectedChange: "wsh(sortedmulti(2,[fp0/84h/1h/0h]tpub0/1/*," +
+				"[fp1/84h/1h/0h]tpub1/1/*,[fp2/84h/1h/0h]tpub2/1/*))#chk",
+		},
+		"ThresholdAboveSigners": {
+			opts:               []privatebtc.Option{privatebtc.WithWallet("test")},
+			required:           3,
+			signers:            []int{0, 1},
+			withoutDescriptors: -1,
+			expectedError:      privatebtc.ErrInvalidMultisigThreshold,
+		},
+		"ZeroThreshold": {
+			opts:               []privatebtc.Option{privatebtc.WithWallet("test")},
+			required:           0,
+			signers:            []int{0, 1},
+			withoutDescriptors: -1,
+			expectedError:      privatebtc.ErrInvalidMultisigThreshold,
+		},
+		"SignerNotFound": {
+			opts:               []privatebtc.Option{privatebtc.WithWallet("test")},
+			required:           1,
+			signers:            []int{0, 5},
+			withoutDescriptors: -1,
+			expectedError:      privatebtc.ErrNodeNotFound,
+		},
+		"NodeWithoutWallet": {
+			required:           1,
+			signers:            []int{0, 1},
+			withoutDescriptors: -1,
+			expectedError:      privatebtc.ErrNodeWithoutWallet,
+		},
+		"SignerDescriptorNotFound": {
+			opts:               []privatebtc.Option{privatebtc.WithWallet("test")},
+			required:           1,
+			signers:            []int{0, 1},
+			withoutDescriptors: 1,
+			expectedError:      privatebtc.ErrSignerDescriptorNotFound,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := require.New(t)
+
+			wallets := newMultisigWallets()
+			wallets.withoutDescriptors = test.withoutDescriptors
+
+			mocks := newMockNodes(wallets.rpcClient)
+
+			pn, err := privatebtc.NewPrivateNetwork(
+				mocks.nodeService(3),
+				mocks.rpcClientFactory(),
+				3,
+				test.opts...,
+			)
+			req.NoError(err)
+
+			req.NoError(pn.Start(context.Background()))
+
+			w, err := pn.CreateMultisigWallet(context.Background(), "multi", test.required, 2, test.signers...)
+			req.ErrorIs(err, test.expectedError)
+
+			if test.expectedError != nil {
+				req.Empty(wallets.created)
+				req.Empty(wallets.imported)
+
+				return
+			}
+
+			req.Equal("multi", w.Name())
+			req.Equal(test.required, w.Required())
+			req.Equal(2, w.Coordinator().ID())
+			req.Len(w.Signers(), len(test.signers))
+			req.Equal(test.expectedReceive, w.ReceiveDescriptor())
+			req.Equal(test.expectedChange, w.ChangeDescriptor())
+
+			req.Equal(map[int]string{2: "multi"}, wallets.created)
+			req.Equal(map[int][]privatebtc.ImportDescriptorRequest{
+				2: {
+					{Desc: test.expectedReceive, Active: true},
+					{Desc: test.expectedChange, Active: true, Internal: true},
+				},
+			}, wallets.imported)
+		})
+	}
+
+	t.Run("Send", func(t *testing.T) {
+		t.Parallel()
+
+		req := require.New(t)
+
+		wallets := newMultisigWallets()
+
+		mocks := newMockNodes(wallets.sendingRPCClient)
+
+		pn, err := privatebtc.NewPrivateNetwork(
+			mocks.nodeService(3),
+			mocks.rpcClientFactory(),
+			3,
+			privatebtc.WithWallet("test"),
+		)
+		req.NoError(err)
+
+		req.NoError(pn.Start(context.Background()))
+
+		w, err := pn.CreateMultisigWallet(context.Background(), "multi", 2, 0, 0, 1, 2)
+		req.NoError(err)
+
+		_, err = w.Send(context.Background(), map[string]privatebtc.Amount{"addr": privatebtc.BTC}, 1, 5)
+		req.ErrorIs(err, privatebtc.ErrNodeNotFound)
+
+		txHash, err := w.Send(context.Background(), map[string]privatebtc.Amount{"addr": privatebtc.BTC}, 2, 1)
+		req.NoError(err)
+
+		req.Equal("txhash", txHash)
+		req.Equal([]string{"psbt_signed_2", "psbt_signed_1"}, wallets.combined)
+		req.Equal([]string{"txhex"}, wallets.sent)
+
+		txHash, err = w.BumpFee(context.Background(), txHash, 20, 0, 2)
+		req.NoError(err)
+
+		req.Equal("txhash", txHash)
+		req.Equal([]string{"bumped_signed_0", "bumped_signed_2"}, wallets.combined)
+		req.Equal([]string{"txhex", "txhex"}, wallets.sent)
+	})
+}
@@ -47,6 +47,63 @@ func (n Node) RPCClient() RPCClient {
 	return n.rpcClient
 }
 
+// Wallet returns the RPC client of the node scoped to the wallet with the given name.
+func (n Node) Wallet(walletName string) (RPCClient, error) {
+	rpcClient, err := n.rpcClient.Wallet(walletName)
+	if err != nil {
+		return nil, fmt.Errorf("wallet %q: %w", walletName, err)
+	}
+
+	return rpcClient, nil
+}
+
+// WalletClient returns the RPC client of the node scoped to the wallet the node
+// was configured with, see WithWallet.
+// Once a node has more than one wallet loaded, the node wallet RPCs have to be
+// sent through this client instead of RPCClient.
+func (n Node) WalletClient() (RPCClient, error) {
+	return n.pn.nodeWalletClient(n)
+}
+
+// CreateWallet creates a wallet with the given name on the node, next to the wallets
+// already loaded, and returns the RPC client scoped to it.
+func (n Node) CreateWallet(ctx context.Context, walletName string) (RPCClient, error) {
+	if err := n.rpcClient.CreateWallet(ctx, walletName); err != nil {
+		return nil, fmt.Errorf("create wallet: %w", err)
+	}
+
+	return n.Wallet(walletName)
+}
+
+// LoadWallet loads the existing wallet with the given name on the node and returns
+// the RPC client scoped to it.
+func (n Node) LoadWallet(ctx context.Context, walletName string) (RPCClient, error) {
+	if err := n.rpcClient.LoadWallet(ctx, walletName); err != nil {
+		return nil, fmt.Errorf("load wallet: %w", err)
+	}
+
+	return n.Wallet(walletName)
+}
+
+// UnloadWallet unloads the wallet with the given name from the node.
+func (n Node) UnloadWallet(ctx context.Context, walletName string) error {
+	if err := n.rpcClient.UnloadWallet(ctx, walletName); err != nil {
+		return fmt.Errorf("unload wallet: %w", err)
+	}
+
+	return nil
+}
+
+// ListWallets returns the names of the wallets loaded on the node.
+func (n Node) ListWallets(ctx context.Context) ([]string, error) {
+	wallets, err := n.rpcClient.ListWallets(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list wallets: %w", err)
+	}
+
+	return wallets, nil
+}
+
 // Fund is a helper function for funding a node.
 // It generates a new address to the block and mines 101 blocks to it,
 // returning the Hash of the last block that was mined
@@ -67,16 +124,12 @@ func (n Node) Fund(ctx context.Context) (string, error) {
 	return hashes[len(hashes)-1], nil
 }
 
-// DisconnectFromNetwork disconnects the node from the network (the other nodes).
+// DisconnectFromNetwork disconnects the node from the network (its peers in the network topology).
 func (n Node) DisconnectFromNetwork(ctx context.Context) error {
 	eg, egCtx := errgroup.WithContext(ctx)
 
-	for i := range n.pn.nodes {
-		node := n.pn.nodes[i]
-
-		if node.id == n.id {
-			continue
-		}
+	for _, node := range n.pn.peersOf(n.id) {
+		node := node
 
 		eg.Go(func() error {
 			if err := node.RPCClient().RemovePeer(egCtx, n); err != nil {
@@ -90,22 +143,17 @@ func (n Node) DisconnectFromNetwork(ctx context.Context) error {
 	return eg.Wait()
 }
 
-// ConnectToNetwork connects the node to the network (the other nodes).
+// ConnectToNetwork connects the node to the network (its peers in the network topology).
 func (n Node) ConnectToNetwork(ctx context.Context) error {
 	eg, egCtx := errgroup.WithContext(ctx)
 
-	for i, node := range n.pn.nodes {
-		if node.id == n.id {
-			continue
-		}
-
+	for _, node := range n.pn.peersOf(n.id) {
 		node := node
-		i := i
 
 		eg.Go(func() error {
 			err := node.RPCClient().AddPeer(egCtx, n)
 			if err != nil {
-				return fmt.Errorf("add node %d: %w", i, err)
+				return fmt.Errorf("add node %d: %w", node.id, err)
 			}
 
 			return nil
@@ -137,6 +185,10 @@ type NodeHandler interface {
 	InternalIP() string
 	HostRPCPort() string
 	Name() string
+	// ExportDatadir returns a tar archive of the node data directory.
+	// The archive entries are relative to the parent of the data directory,
+	// the same layout is expected when a node is restored from a snapshot.
+	ExportDatadir(ctx context.Context) (io.ReadCloser, error)
 }
 
 // Nodes is a slice of nodes.
@@ -281,6 +333,8 @@ func (nodes Nodes) EnsureTransactionNotInAnyMempool(ctx context.Context, txHash
 type NetworkMempoolTransaction struct {
 	MempoolTransaction
 	Nodes []int
+	// Entries are the mempool entries of the transaction, keyed by the nodes in Nodes.
+	Entries map[int]*MempoolEntry
 }
 
 // NetworkMempool represents the network mempool.
@@ -312,33 +366,75 @@ func (nodes Nodes) NetworkMempool(ctx context.Context) (NetworkMempool, error) {
 				return fmt.Errorf("get mempool for node %d: %w", i, err)
 			}
 
-			for _, txHash := range nodeMempoolTxs {
-				mutex.Lock()
+			// the transactions already fetched from other nodes are only marked as
+			// being in this node mempool, the rest are fetched at once.
+			var newTxHashes []string
 
+			mutex.Lock()
+
+			for _, txHash := range nodeMempoolTxs {
 				if mpTx, exists := mempoolTransactions[txHash]; exists {
 					mpTx.Nodes = append(mpTx.Nodes, i)
 
-					mutex.Unlock()
+					continue
+				}
+
+				mempoolTransactions[txHash] = &NetworkMempoolTransaction{
+					MempoolTransaction: MempoolTransaction{Hash: txHash},
+					Nodes:              []int{i},
+					Entries:            map[int]*MempoolEntry{},
+				}
+
+				newTxHashes = append(newTxHashes, txHash)
+			}
+
+			mutex.Unlock()
+
+			txsOutputs, err := getTransactionsOutputs(egCtx, nodes[i].RPCClient(), newTxHashes)
+			if err != nil {
+				return fmt.Errorf("get transaction outputs: %w", err)
+			}
+
+			// the entries are fetched for every transaction, as each node has its own view
+			// of the transaction ancestors and descendants.
+			entries, err := getMempoolEntries(egCtx, nodes[i].RPCClient(), nodeMempoolTxs)
+			if err != nil {
+				return fmt.Errorf("get mempool entries for node %d: %w", i, err)
+			}
+
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			// the transactions which were mined or replaced since the mempool was listed.
+			left := map[string]bool{}
+
+			for j, txHash := range newTxHashes {
+				if txsOutputs[j] == nil {
+					left[txHash] = true
 
 					continue
 				}
 
-				txOutputs, err := nodes[i].RPCClient().GetTransactionOutputs(egCtx, txHash)
-				if err != nil {
-					mutex.Unlock()
+				mempoolTransactions[txHash].Outputs = txsOutputs[j]
+			}
 
-					return fmt.Errorf("get transaction outputs: %w", err)
+			for j, txHash := range nodeMempoolTxs {
+				mpTx, exists := mempoolTransactions[txHash]
+				if !exists || left[txHash] {
+					continue
 				}
 
-				mempoolTransactions[txHash] = &NetworkMempoolTransaction{
-					MempoolTransaction: MempoolTransaction{
-						Hash:    txHash,
-						Outputs: txOutputs,
-					},
-					Nodes: []int{i},
+				if entries[j] == nil {
+					left[txHash] = true
+
+					continue
 				}
 
-				mutex.Unlock()
+				mpTx.Entries[i] = entries[j]
+			}
+
+			for txHash := range left {
+				mempoolTransactions.removeNode(txHash, i)
 			}
 
 			return nil
@@ -349,6 +445,10 @@ func (nodes Nodes) NetworkMempool(ctx context.Context) (NetworkMempool, error) {
 		return NetworkMempool{}, fmt.Errorf("fetch nodes mempools: %w", err)
 	}
 
+	if err := fetchMissingOutputs(ctx, nodes, mempoolTransactions); err != nil {
+		return NetworkMempool{}, err
+	}
+
 	for i := range mempoolTransactions {
 		slices.Sort(mempoolTransactions[i].Nodes)
 	}
@@ -356,14 +456,127 @@ func (nodes Nodes) NetworkMempool(ctx context.Context) (NetworkMempool, error) {
 	return mempoolTransactions, nil
 }
 
+// removeNode removes the node with the given index, along with its mempool entry,
+// from the given transaction. The transaction is removed once no node has it.
+func (m NetworkMempool) removeNode(txHash string, node int) {
+	mpTx, exists := m[txHash]
+	if !exists {
+		return
+	}
+
+	mpTx.Nodes = slices.DeleteFunc(mpTx.Nodes, func(n int) bool { return n == node })
+	delete(mpTx.Entries, node)
+
+	if len(mpTx.Nodes) == 0 {
+		delete(m, txHash)
+	}
+}
+
+// fetchMissingOutputs fetches the outputs of the transactions which left the mempool
+// of the node which listed them first from the other nodes which listed them.
+func fetchMissingOutputs(ctx context.Context, nodes Nodes, mempool NetworkMempool) error {
+	for txHash, mpTx := range mempool {
+		for _, node := range slices.Clone(mpTx.Nodes) {
+			if mpTx.Outputs != nil {
+				break
+			}
+
+			outputs, err := nodes[node].RPCClient().GetTransactionOutputs(ctx, txHash)
+
+			switch {
+			case errors.Is(err, ErrTxNotFoundInMempool):
+				mempool.removeNode(txHash, node)
+			case err != nil:
+				return fmt.Errorf("get tx %q outputs from node %d: %w", txHash, node, err)
+			default:
+				mpTx.Outputs = outputs
+			}
+		}
+	}
+
+	return nil
+}
+
+// getTransactionsOutputs returns the outputs of the given transactions, in a single
+// round trip if the RPC client supports it. The outputs of the transactions which
+// left the mempool since they were listed are nil.
+func getTransactionsOutputs(
+	ctx context.Context,
+	rpcClient RPCClient,
+	txHashes []string,
+) ([][]MempoolTransactionOutput, error) {
+	if len(txHashes) == 0 {
+		return nil, nil
+	}
+
+	if batchGetter, ok := rpcClient.(BatchTransactionOutputsGetter); ok {
+		return batchGetter.GetTransactionsOutputs(ctx, txHashes)
+	}
+
+	txsOutputs := make([][]MempoolTransactionOutput, len(txHashes))
+
+	for i, txHash := range txHashes {
+		txOutputs, err := rpcClient.GetTransactionOutputs(ctx, txHash)
+
+		switch {
+		// the transaction left the mempool since it was listed.
+		case errors.Is(err, ErrTxNotFoundInMempool):
+			continue
+		case err != nil:
+			return nil, fmt.Errorf("tx %q: %w", txHash, err)
+		}
+
+		txsOutputs[i] = txOutputs
+	}
+
+	return txsOutputs, nil
+}
+
+// getMempoolEntries returns the mempool entries of the given transactions, in a
+// single round trip if the RPC client supports it. The entries of the transactions
+// which left the mempool since they were listed are nil.
+func getMempoolEntries(
+	ctx context.Context,
+	rpcClient RPCClient,
+	txHashes []string,
+) ([]*MempoolEntry, error) {
+	if len(txHashes) == 0 {
+		return nil, nil
+	}
+
+	if batchGetter, ok := rpcClient.(BatchMempoolEntriesGetter); ok {
+		return batchGetter.GetMempoolEntries(ctx, txHashes)
+	}
+
+	entries := make([]*MempoolEntry, len(txHashes))
+
+	for i, txHash := range txHashes {
+		entry, err := rpcClient.GetMempoolEntry(ctx, txHash)
+
+		switch {
+		// the transaction left the mempool since it was listed.
+		case errors.Is(err, ErrTxNotFoundInMempool):
+			continue
+		case err != nil:
+			return nil, fmt.Errorf("tx %q: %w", txHash, err)
+		}
+
+		entries[i] = entry
+	}
+
+	return entries, nil
+}
+
 // Balance is a balance of a node.
 type Balance struct {
-	Trusted  float64
-	Pending  float64
-	Immature float64
+	Trusted  Amount
+	Pending  Amount
+	Immature Amount
 }
 
-func connectNodes(ctx context.Context, nodes []Node) error {
+// connectNodes connects the nodes as described by the given peer graph and waits
+// until every node reports the expected number of peers.
+func connectNodes(ctx context.Context, nodes []Node, peers peerGraph) error {
 	connectionCount, err := nodes[0].RPCClient().GetConnectionCount(ctx)
 	if err != nil {
 		return fmt.Errorf("get connection count for first node: %w", err)
@@ -373,21 +586,24 @@ func connectNodes(ctx context.Context, nodes []Node) error {
 		return &peerCountShouldBeZeroError{got: connectionCount}
 	}
 
+	nodesByID := make(map[int]Node, len(nodes))
+
+	for _, node := range nodes {
+		nodesByID[node.id] = node
+	}
+
 	eg, egCtx := errgroup.WithContext(ctx)
 
-	for nodeIdx := range nodes {
-		for nextNodeIdx := nodeIdx + 1; nextNodeIdx < len(nodes); nextNodeIdx++ {
-			nextNode := nodes[nextNodeIdx]
-			node := nodes[nodeIdx]
+	for _, l := range peers.links() {
+		node, nextNode := nodesByID[l.from], nodesByID[l.to]
 
-			eg.Go(func() error {
-				if err := node.RPCClient().AddPeer(egCtx, nextNode); err != nil {
-					return fmt.Errorf("add node %d: %w", nextNode.id, err)
-				}
+		eg.Go(func() error {
+			if err := node.RPCClient().AddPeer(egCtx, nextNode); err != nil {
+				return fmt.Errorf("add node %d: %w", nextNode.id, err)
+			}
 
-				return nil
-			})
-		}
+			return nil
+		})
 	}
 
 	if err := eg.Wait(); err != nil {
@@ -399,6 +615,8 @@ func connectNodes(ctx context.Context, nodes []Node) error {
 	for i := range nodes {
 		i := i
 
+		expectedPeerCount := len(peers.peers(nodes[i].id))
+
 		eg.Go(func() error {
 			const attempts = 5
 
@@ -412,10 +630,10 @@ func connectNodes(ctx context.Context, nodes []Node) error {
 					)
 				}
 
-				if peerCount != len(nodes)-1 {
+				if peerCount != expectedPeerCount {
 					return &UnexpectedPeerCountError{
 						nodeName: nodes[i].Name(),
-						expected: len(nodes) - 1,
+						expected: expectedPeerCount,
 						got:      peerCount,
 					}
 				}
@@ -0,0 +1,156 @@
+package privatebtc
+
+import (
+	"fmt"
+)
+
+type nodeOptions struct {
+	bitcoinClientVersion string
+	walletName           *string
+	prune                uint
+	txIndex              *bool
+	blockFilterIndex     bool
+	coinStatsIndex       bool
+	args                 []string
+}
+
+// A NodeOption configures a single node of the Bitcoin Private Network.
+// Node options take precedence over the network options.
+type NodeOption interface {
+	applyNode(*nodeOptions)
+}
+
+// newNodeOptions resolves the node options on top of the network bitcoin client
+// version and wallet name.
+func newNodeOptions(
+	bitcoinClientVersion string,
+	walletName *string,
+	opts []NodeOption,
+) (*nodeOptions, error) {
+	nodeOpts := &nodeOptions{
+		bitcoinClientVersion: bitcoinClientVersion,
+		walletName:           walletName,
+		prune:                0,
+		txIndex:              nil,
+		blockFilterIndex:     false,
+		coinStatsIndex:       false,
+		args:                 nil,
+	}
+
+	for i := range opts {
+		opts[i].applyNode(nodeOpts)
+	}
+
+	if nodeOpts.prune != 0 && nodeOpts.txIndex != nil && *nodeOpts.txIndex {
+		return nil, fmt.Errorf("prune %d: %w", nodeOpts.prune, ErrPrunedNodeWithTxIndex)
+	}
+
+	return nodeOpts, nil
+}
+
+// request returns the create node request fields configured by the node options.
+func (o *nodeOptions) request(req CreateNodeRequest) CreateNodeRequest {
+	// a pruned node cannot maintain a transaction index, the index is only
+	// enabled by default for nodes that are not pruned.
+	txIndex := o.prune == 0
+
+	if o.txIndex != nil {
+		txIndex = *o.txIndex
+	}
+
+	req.BitcoinClientVersion = o.bitcoinClientVersion
+	req.Prune = o.prune
+	req.TxIndex = txIndex
+	req.BlockFilterIndex = o.blockFilterIndex
+	req.CoinStatsIndex = o.coinStatsIndex
+	req.Args = append([]string{}, o.args...)
+
+	return req
+}
+
+type withNodeBitcoinClientVersion string
+
+func (v withNodeBitcoinClientVersion) applyNode(opts *nodeOptions) {
+	opts.bitcoinClientVersion = string(v)
+}
+
+// WithNodeBitcoinClientVersion configures the version of the bitcoin client the node runs,
+// overriding the network WithBitcoinClientVersion option.
+func WithNodeBitcoinClientVersion(version string) NodeOption {
+	return withNodeBitcoinClientVersion(version)
+}
+
+type withNodeWallet string
+
+func (w withNodeWallet) applyNode(opts *nodeOptions) {
+	s := string(w)
+
+	opts.walletName = &s
+}
+
+// WithNodeWallet configures and creates a wallet for the node.
+func WithNodeWallet(walletName string) NodeOption {
+	return withNodeWallet(walletName)
+}
+
+type withNodePrune uint
+
+func (p withNodePrune) applyNode(opts *nodeOptions) {
+	opts.prune = uint(p)
+}
+
+// WithNodePrune enables block pruning for the node, targetMiB is passed as is to
+// the bitcoind -prune argument.
+// A pruned node does not maintain a transaction index, enabling both results in
+// ErrPrunedNodeWithTxIndex.
+func WithNodePrune(targetMiB uint) NodeOption {
+	return withNodePrune(targetMiB)
+}
+
+type withNodeTxIndex bool
+
+func (w withNodeTxIndex) applyNode(opts *nodeOptions) {
+	b := bool(w)
+
+	opts.txIndex = &b
+}
+
+// WithNodeTxIndex configures whether the node maintains a full transaction index.
+// By default, the index is enabled for nodes that are not pruned.
+func WithNodeTxIndex(enabled bool) NodeOption {
+	return withNodeTxIndex(enabled)
+}
+
+type withNodeBlockFilterIndex bool
+
+func (w withNodeBlockFilterIndex) applyNode(opts *nodeOptions) {
+	opts.blockFilterIndex = bool(w)
+}
+
+// WithNodeBlockFilterIndex configures whether the node maintains compact block filters.
+func WithNodeBlockFilterIndex(enabled bool) NodeOption {
+	return withNodeBlockFilterIndex(enabled)
+}
+
+type withNodeCoinStatsIndex bool
+
+func (w withNodeCoinStatsIndex) applyNode(opts *nodeOptions) {
+	opts.coinStatsIndex = bool(w)
+}
+
+// WithNodeCoinStatsIndex configures whether the node maintains the coinstats index.
+func WithNodeCoinStatsIndex(enabled bool) NodeOption {
+	return withNodeCoinStatsIndex(enabled)
+}
+
+type withNodeArgs []string
+
+func (w withNodeArgs) applyNode(opts *nodeOptions) {
+	opts.args = append(opts.args, w...)
+}
+
+// WithNodeArgs appends extra arguments to the bitcoind command line of the node,
+// e.g. "-blocksonly=1".
+func WithNodeArgs(args ...string) NodeOption {
+	return withNodeArgs(args)
+}
@@ -0,0 +1,233 @@
+package privatebtc_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/adrianbrad/privatebtc"
+	"github.com/adrianbrad/privatebtc/mock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+func TestNodesNetworkMempool(t *testing.T) {
+	t.Parallel()
+
+	errGetMempoolEntry := errors.New("get mempool entry error")
+
+	// node 0 has the parent and the child transactions, node 1 only the parent.
+	mempools := map[int][]string{
+		0: {"parent", "child"},
+		1: {"parent"},
+	}
+
+	tests := map[string]struct {
+		getMempoolEntryErr error
+		expectedError      error
+	}{
+		"Success": {},
+		"GetMempoolEntryError": {
+			getMempoolEntryErr: errGetMempoolEntry,
+			expectedError:      errGetMempoolEntry,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := require.New(t)
+
+			mocks := newMockNodes(func(id int) *mock.RPCClient {
+				return &mock.RPCClient{
+					GetRawMempoolFunc: func(context.Context) ([]string, error) {
+						return mempools[id], nil
+					},
+					GetTransactionOutputsFunc: func(
+						_ context.Context,
+						txHash string,
+					) ([]privatebtc.MempoolTransactionOutput, error) {
+						return []privatebtc.MempoolTransactionOutput{{Address: txHash, Value: privatebtc.BTC}}, nil
+					},
+					GetMempoolEntryFunc: func(_ context.Context, txHash string) (*privatebtc.MempoolEntry, error) {
+						if test.getMempoolEntryErr != nil {
+							return nil, test.getMempoolEntryErr
+						}
+
+						return &privatebtc.MempoolEntry{
+							VSize:             141,
+							Fee:               1410,
+							Time:              time.Unix(int64(id), 0),
+							AncestorCount:     1,
+							DescendantCount:   len(mempools[id]),
+							BIP125Replaceable: true,
+						}, nil
+					},
+				}
+			})
+
+			pn, err := privatebtc.NewPrivateNetwork(
+				mocks.nodeService(2),
+				mocks.rpcClientFactory(),
+				2,
+			)
+			req.NoError(err)
+
+			req.NoError(pn.Start(context.Background()))
+
+			mempool, err := pn.Nodes().NetworkMempool(context.Background())
+			req.ErrorIs(err, test.expectedError)
+
+			if test.expectedError != nil {
+				return
+			}
+
+			req.Equal([]string{"child", "parent"}, mempool.Hashes())
+
+			parent := mempool["parent"]
+			req.Equal([]int{0, 1}, parent.Nodes)
+			req.Equal([]privatebtc.MempoolTransactionOutput{{Address: "parent", Value: privatebtc.BTC}}, parent.Outputs)
+			req.Len(parent.Entries, 2)
+			req.Equal(2, parent.Entries[0].DescendantCount)
+			req.Equal(1, parent.Entries[1].DescendantCount)
+			req.Equal(time.Unix(1, 0), parent.Entries[1].Time)
+			req.Equal(privatebtc.FeeRate(10), parent.Entries[1].FeeRate())
+
+			child := mempool["child"]
+			req.Equal([]int{0}, child.Nodes)
+			req.Len(child.Entries, 1)
+			req.True(child.Entries[0].BIP125Replaceable)
+		})
+	}
+}
+
+func TestNodesNetworkMempoolTxLeftMempool(t *testing.T) {
+	t.Parallel()
+
+	req := require.New(t)
+
+	// node 0 lists the parent and the child transactions, node 1 the parent and
+	// another transaction. The parent is mined on node 0 before its outputs and entry
+	// are fetched, the other transaction is replaced on node 1 before its entry is fetched.
+	mempools := map[int][]string{
+		0: {"parent", "child"},
+		1: {"parent", "other"},
+	}
+
+	var (
+		outputsLeft = map[int]string{0: "parent"}
+		entriesLeft = map[int][]string{0: {"parent"}, 1: {"other"}}
+	)
+
+	mocks := newMockNodes(func(id int) *mock.RPCClient {
+		return &mock.RPCClient{
+			GetRawMempoolFunc: func(context.Context) ([]string, error) {
+				return mempools[id], nil
+			},
+			GetTransactionOutputsFunc: func(
+				_ context.Context,
+				txHash string,
+			) ([]privatebtc.MempoolTransactionOutput, error) {
+				if outputsLeft[id] == txHash {
+					return nil, privatebtc.ErrTxNotFoundInMempool
+				}
+
+				return []privatebtc.MempoolTransactionOutput{{Address: "addr_" + txHash}}, nil
+			},
+			GetMempoolEntryFunc: func(_ context.Context, txHash string) (*privatebtc.MempoolEntry, error) {
+				if slices.Contains(entriesLeft[id], txHash) {
+					return nil, privatebtc.ErrTxNotFoundInMempool
+				}
+
+				return &privatebtc.MempoolEntry{VSize: 141}, nil
+			},
+		}
+	})
+
+	pn, err := privatebtc.NewPrivateNetwork(mocks.nodeService(2), mocks.rpcClientFactory(), 2)
+	req.NoError(err)
+
+	req.NoError(pn.Start(context.Background()))
+
+	mempool, err := pn.Nodes().NetworkMempool(context.Background())
+	req.NoError(err)
+
+	req.Equal([]string{"child", "parent"}, mempool.Hashes())
+
+	// the parent outputs are fetched from node 1 if node 0 listed the parent first.
+	req.Equal([]int{1}, mempool["parent"].Nodes)
+	req.Equal(map[int]*privatebtc.MempoolEntry{1: {VSize: 141}}, mempool["parent"].Entries)
+	req.Equal([]privatebtc.MempoolTransactionOutput{{Address: "addr_parent"}}, mempool["parent"].Outputs)
+
+	req.Equal([]int{0}, mempool["child"].Nodes)
+	req.Equal([]privatebtc.MempoolTransactionOutput{{Address: "addr_child"}}, mempool["child"].Outputs)
+}
+
+func TestNodeWallets(t *testing.T) {
+	t.Parallel()
+
+	req := require.New(t)
+
+	var (
+		loaded = map[string]bool{}
+		scoped = map[string]*mock.RPCClient{}
+	)
+
+	mocks := newMockNodes(func(int) *mock.RPCClient {
+		return &mock.RPCClient{
+			CreateWalletFunc: func(_ context.Context, walletName string) error {
+				loaded[walletName] = true
+
+				return nil
+			},
+			UnloadWalletFunc: func(_ context.Context, walletName string) error {
+				delete(loaded, walletName)
+
+				return nil
+			},
+			ListWalletsFunc: func(context.Context) ([]string, error) {
+				return maps.Keys(loaded), nil
+			},
+			WalletFunc: func(walletName string) (privatebtc.RPCClient, error) {
+				scoped[walletName] = &mock.RPCClient{}
+
+				return scoped[walletName], nil
+			},
+		}
+	})
+
+	pn, err := privatebtc.NewPrivateNetwork(
+		mocks.nodeService(1),
+		mocks.rpcClientFactory(),
+		1,
+		privatebtc.WithWallet("hot"),
+	)
+	req.NoError(err)
+
+	req.NoError(pn.Start(context.Background()))
+
+	node := pn.Nodes()[0]
+
+	cold, err := node.CreateWallet(context.Background(), "cold")
+	req.NoError(err)
+	req.Same(scoped["cold"], cold)
+
+	hot, err := node.WalletClient()
+	req.NoError(err)
+	req.Same(scoped["hot"], hot)
+
+	wallets, err := node.ListWallets(context.Background())
+	req.NoError(err)
+	req.ElementsMatch([]string{"hot", "cold"}, wallets)
+
+	req.NoError(node.UnloadWallet(context.Background(), "cold"))
+
+	wallets, err = node.ListWallets(context.Background())
+	req.NoError(err)
+	req.Equal([]string{"hot"}, wallets)
+}
@@ -0,0 +1,482 @@
+package privatebtc
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+
+	"github.com/avast/retry-go"
+	"golang.org/x/exp/slices"
+	"golang.org/x/sync/errgroup"
+)
+
+// Network Partition
+// 1. Split the network into N isolated groups of nodes, every peer connection between
+// nodes of different groups is removed.
+// 2. Mine blocks and send transactions on each group independently, every group
+// builds its own chain.
+// 3. Heal the network by restoring the removed peer connections.
+// 4. The group with the longest chain wins and every node reorgs to its chain.
+//
+// Only the connections described by the network topology are restored on heal,
+// nodes of a group are only able to relay blocks and transactions to each other if
+// the topology connects them inside the group.
+
+// Partition represents a private network split into isolated groups of nodes.
+type Partition struct {
+	groups   []Nodes
+	cut      []link
+	nodes    map[int]Node
+	logger   *slog.Logger
+	timeouts timeouts
+
+	mu sync.Mutex // guards the heal state below
+	// healed is set once the links between the groups are restored, along with the
+	// group whose chain won and its best block hash.
+	healed     bool
+	winner     int
+	winnerHash string
+	// synced is set once every node is synced to the winning chain.
+	synced bool
+}
+
+// Partition splits the private network into the given groups of node indexes.
+// Every node has to be part of exactly one group and at least two groups are required.
+// The peer connections between nodes of different groups are removed and the method
+// waits until every node is left only with its peers from the same group.
+func (n *PrivateNetwork) Partition(ctx context.Context, groups ...[]int) (*Partition, error) {
+	nodes, peers := n.snapshot()
+
+	partitionGroups, err := newPartitionGroups(nodes, groups)
+	if err != nil {
+		return nil, err
+	}
+
+	groupOf := map[int]int{}
+
+	nodesByID := make(map[int]Node, len(nodes))
+
+	for i, group := range partitionGroups {
+		for _, node := range group {
+			groupOf[node.id] = i
+			nodesByID[node.id] = node
+		}
+	}
+
+	var cut []link
+
+	for _, l := range peers.links() {
+		if groupOf[l.from] != groupOf[l.to] {
+			cut = append(cut, l)
+		}
+	}
+
+	p := &Partition{
+		groups:   partitionGroups,
+		cut:      cut,
+		nodes:    nodesByID,
+		logger:   n.logger,
+		timeouts: n.timeouts,
+	}
+
+	p.logger.Info("✂️⌛ Partitioning network", "groups", len(groups))
+
+	eg, egCtx := errgroup.WithContext(ctx)
+
+	for _, l := range cut {
+		node, peer := nodesByID[l.from], nodesByID[l.to]
+
+		eg.Go(func() error {
+			if err := node.RPCClient().RemovePeer(egCtx, peer); err != nil {
+				return fmt.Errorf("remove peer %d from node %d: %w", peer.id, node.id, err)
+			}
+
+			return nil
+		})
+	}
+
+	if err := eg.Wait(); err != nil {
+		return nil, fmt.Errorf("cut links between groups: %w", err)
+	}
+
+	if err := p.waitForGroupPeers(ctx, peers, groupOf); err != nil {
+		return nil, fmt.Errorf("wait for group peers: %w", err)
+	}
+
+	p.logger.Info("✂️✅ Successfully partitioned network", "groups", len(groups))
+
+	return p, nil
+}
+
+func newPartitionGroups(nodes Nodes, groups [][]int) ([]Nodes, error) {
+	const minGroups = 2
+
+	if len(groups) < minGroups {
+		return nil, fmt.Errorf("%d groups: %w", len(groups), ErrPartitionNeedsAtLeastTwoGroups)
+	}
+
+	seen := make(map[int]int, len(nodes))
+
+	partitionGroups := make([]Nodes, len(groups))
+
+	for i, group := range groups {
+		if len(group) == 0 {
+			return nil, fmt.Errorf("group %d: %w", i, ErrEmptyPartitionGroup)
+		}
+
+		partitionGroups[i] = make(Nodes, len(group))
+
+		for j, nodeIndex := range group {
+			if nodeIndex < 0 || nodeIndex >= len(nodes) {
+				return nil, fmt.Errorf("group %d, index %d: %w", i, nodeIndex, ErrNodeIndexOutOfRange)
+			}
+
+			if prevGroup, ok := seen[nodeIndex]; ok {
+				return nil, fmt.Errorf(
+					"node index %d in groups %d and %d: %w",
+					nodeIndex,
+					prevGroup,
+					i,
+					ErrNodeInMultiplePartitionGroups,
+				)
+			}
+
+			seen[nodeIndex] = i
+
+			partitionGroups[i][j] = nodes[nodeIndex]
+		}
+	}
+
+	for i := range nodes {
+		if _, ok := seen[i]; !ok {
+			return nil, fmt.Errorf("node index %d: %w", i, ErrNodeNotInAnyPartitionGroup)
+		}
+	}
+
+	return partitionGroups, nil
+}
+
+// waitForGroupPeers waits until every node reports as many peers as it has in its
+// own group.
+func (p *Partition) waitForGroupPeers(
+	ctx context.Context,
+	peers peerGraph,
+	groupOf map[int]int,
+) error {
+	eg, egCtx := errgroup.WithContext(ctx)
+
+	for _, node := range p.nodes {
+		node := node
+
+		expectedPeerCount := len(slices.DeleteFunc(peers.peers(node.id), func(peerID int) bool {
+			return groupOf[peerID] != groupOf[node.id]
+		}))
+
+		eg.Go(func() error {
+			const attempts = 5
+
+			return retry.Do(func() error {
+				peerCount, err := node.RPCClient().GetConnectionCount(egCtx)
+				if err != nil {
+					return fmt.Errorf("get connection count for node %d: %w", node.id, err)
+				}
+
+				if peerCount != expectedPeerCount {
+					return &UnexpectedPeerCountError{
+						nodeName: node.Name(),
+						expected: expectedPeerCount,
+						got:      peerCount,
+					}
+				}
+
+				return nil
+			}, retry.Context(egCtx), retry.Attempts(attempts))
+		})
+	}
+
+	return eg.Wait()
+}
+
+// Groups returns a copy of the nodes of every group of the partition.
+func (p *Partition) Groups() []Nodes {
+	groups := make([]Nodes, len(p.groups))
+
+	for i := range p.groups {
+		groups[i] = slices.Clone(p.groups[i])
+	}
+
+	return groups
+}
+
+// Group returns a copy of the nodes of the group with the given index.
+func (p *Partition) Group(group int) (Nodes, error) {
+	if group < 0 || group >= len(p.groups) {
+		return nil, fmt.Errorf("group %d: %w", group, ErrPartitionGroupIndexOutOfRange)
+	}
+
+	return slices.Clone(p.groups[group]), nil
+}
+
+func (p *Partition) isHealed() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.healed
+}
+
+// SendTransaction sends a transaction from the first node of the given group.
+// The transaction is only relayed to the nodes of the same group.
+func (p *Partition) SendTransaction(
+	ctx context.Context,
+	group int,
+	receiverAddress string,
+	amount Amount,
+) (string, error) {
+	if p.isHealed() {
+		return "", ErrPartitionHealed
+	}
+
+	nodes, err := p.Group(group)
+	if err != nil {
+		return "", err
+	}
+
+	p.logger.Info(
+		"⬆️⌛ Sending transaction on partition group",
+		"group",
+		group,
+		"sender_node_id",
+		nodes[0].Name(),
+		"receiver_address",
+		receiverAddress,
+		"amount",
+		amount,
+	)
+
+	hash, err := nodes[0].RPCClient().SendToAddress(ctx, receiverAddress, amount, SendOptions{})
+	if err != nil {
+		return "", fmt.Errorf("send to address: %w", err)
+	}
+
+	p.logger.Info(
+		"⬆️✅ Successfully sent transaction on partition group",
+		"group",
+		group,
+		"sender_node_id",
+		nodes[0].Name(),
+		"tx_hash",
+		hash,
+	)
+
+	return hash, nil
+}
+
+// MineBlocks mines blocks on the first node of the given group and waits until
+// every node of the group is synced to the last mined block.
+func (p *Partition) MineBlocks(ctx context.Context, group int, numBlocks int64) ([]string, error) {
+	if p.isHealed() {
+		return nil, ErrPartitionHealed
+	}
+
+	if numBlocks < 1 {
+		return nil, fmt.Errorf("%d blocks: %w", numBlocks, ErrInvalidNumBlocks)
+	}
+
+	nodes, err := p.Group(group)
+	if err != nil {
+		return nil, err
+	}
+
+	const (
+		burningAddr = "bcrt1qzlfc3dw3ecjncvkwmwpvs84ejqzp4fr4agghm8"
+	)
+
+	p.logger.Info(
+		"⏹️⌛ Mine blocks on partition group",
+		"group",
+		group,
+		"miner_node_id",
+		nodes[0].Name(),
+		"num_blocks",
+		numBlocks,
+	)
+
+	blockHashes, err := nodes[0].RPCClient().GenerateToAddress(ctx, numBlocks, burningAddr)
+	if err != nil {
+		return nil, fmt.Errorf("generate to address: %w", err)
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, p.timeouts.sync)
+	defer cancel()
+
+	if err := nodes.Sync(ctxTimeout, blockHashes[len(blockHashes)-1]); err != nil {
+		return nil, fmt.Errorf("sync group %d nodes: %w", group, err)
+	}
+
+	p.logger.Info(
+		"⏹️✅ Successfully mined blocks on partition group",
+		"group",
+		group,
+		"miner_node_id",
+		nodes[0].Name(),
+		"num_blocks",
+		numBlocks,
+		"block_hashes",
+		blockHashes,
+	)
+
+	return blockHashes, nil
+}
+
+// Heal restores the peer connections removed by the partition and waits until every
+// node is synced to the longest chain.
+// The index of the group whose chain won is returned.
+// If the groups did not diverge, every group is on the same chain and 0 is returned.
+// If the longest chains of several groups have the same length but different tips,
+// the connections are restored but no chain wins, -1 is returned along with
+// ErrPartitionChainsTied. Mining a new block on any of the groups resolves the tie.
+// Heal can be retried if it fails: the connections are only restored once, a retry
+// after they were restored only waits again for the nodes to sync to the winning chain.
+// Once Heal succeeds, or the chains are tied, ErrPartitionHealed is returned.
+func (p *Partition) Heal(ctx context.Context) (int, error) {
+	p.mu.Lock()
+	healed, synced, winner, winnerHash := p.healed, p.synced, p.winner, p.winnerHash
+	p.mu.Unlock()
+
+	if synced {
+		return -1, ErrPartitionHealed
+	}
+
+	if !healed {
+		var err error
+
+		winner, winnerHash, err = p.restoreLinks(ctx)
+		if err != nil {
+			return -1, err
+		}
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, p.timeouts.sync)
+	defer cancel()
+
+	var nodes Nodes
+
+	for _, group := range p.groups {
+		nodes = append(nodes, group...)
+	}
+
+	if err := nodes.Sync(ctxTimeout, winnerHash); err != nil {
+		return -1, fmt.Errorf("sync nodes to group %d chain: %w", winner, err)
+	}
+
+	p.mu.Lock()
+	p.synced = true
+	p.mu.Unlock()
+
+	p.logger.Info(
+		"🩹✅ Successfully healed network partition",
+		"winner_group",
+		winner,
+		"best_block_hash",
+		winnerHash,
+	)
+
+	return winner, nil
+}
+
+// restoreLinks restores the peer connections removed by the partition and returns
+// the group whose chain won along with its best block hash.
+// The partition is marked as healed once the connections are restored.
+// nolint: gocognit
+func (p *Partition) restoreLinks(ctx context.Context) (int, string, error) {
+	var (
+		blockCounts = make([]int, len(p.groups))
+		bestHashes  = make([]string, len(p.groups))
+	)
+
+	eg, egCtx := errgroup.WithContext(ctx)
+
+	for i := range p.groups {
+		i := i
+
+		eg.Go(func() error {
+			var err error
+
+			blockCounts[i], err = p.groups[i][0].RPCClient().GetBlockCount(egCtx)
+			if err != nil {
+				return fmt.Errorf("get group %d block count: %w", i, err)
+			}
+
+			bestHashes[i], err = p.groups[i][0].RPCClient().GetBestBlockHash(egCtx)
+			if err != nil {
+				return fmt.Errorf("get group %d best block hash: %w", i, err)
+			}
+
+			return nil
+		})
+	}
+
+	if err := eg.Wait(); err != nil {
+		return -1, "", err
+	}
+
+	p.logger.Info("🩹⌛ Healing network partition", "block_counts", blockCounts)
+
+	eg, egCtx = errgroup.WithContext(ctx)
+
+	for _, l := range p.cut {
+		node, peer := p.nodes[l.from], p.nodes[l.to]
+
+		eg.Go(func() error {
+			if err := node.RPCClient().AddPeer(egCtx, peer); err != nil {
+				return fmt.Errorf("add node %d to node %d: %w", peer.id, node.id, err)
+			}
+
+			return nil
+		})
+	}
+
+	if err := eg.Wait(); err != nil {
+		return -1, "", fmt.Errorf("restore links between groups: %w", err)
+	}
+
+	winner := 0
+
+	for i := range blockCounts {
+		if blockCounts[i] > blockCounts[winner] {
+			winner = i
+		}
+	}
+
+	tied := -1
+
+	for i := range blockCounts {
+		if i != winner && blockCounts[i] == blockCounts[winner] && bestHashes[i] != bestHashes[winner] {
+			tied = i
+
+			break
+		}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.healed = true
+	p.winner, p.winnerHash = winner, bestHashes[winner]
+
+	if tied != -1 {
+		// no chain to sync to, the partition is done.
+		p.synced = true
+
+		return -1, "", fmt.Errorf(
+			"groups %d and %d at block count %d: %w",
+			winner,
+			tied,
+			blockCounts[winner],
+			ErrPartitionChainsTied,
+		)
+	}
+
+	return winner, bestHashes[winner], nil
+}
@@ -0,0 +1,366 @@
+package privatebtc_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/adrianbrad/privatebtc"
+	"github.com/adrianbrad/privatebtc/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// partitionChains simulates the chain of every mock node, once the partition is
+// healed every node switches to the chain with the highest block count.
+type partitionChains struct {
+	mu          sync.Mutex
+	blockCounts map[int]int
+	// tips are the best block hashes of the nodes before healing, by default
+	// derived from the block counts.
+	tips   map[int]string
+	healed bool
+
+	// addPeerErrors is the number of AddPeer calls which fail once partitioned.
+	addPeerErrors int
+	partitioned   bool
+
+	// bestBlockHashErrors is the number of GetBestBlockHash calls which fail once healed.
+	bestBlockHashErrors int
+}
+
+var (
+	errAddPeer          = errors.New("add peer error")
+	errGetBestBlockHash = errors.New("get best block hash error")
+)
+
+func (c *partitionChains) rpcClient(id int) *mock.RPCClient {
+	return &mock.RPCClient{
+		AddPeerFunc: func(context.Context, privatebtc.Node) error {
+			c.mu.Lock()
+			defer c.mu.Unlock()
+
+			if c.partitioned && c.addPeerErrors > 0 {
+				c.addPeerErrors--
+
+				return errAddPeer
+			}
+
+			c.healed = true
+
+			return nil
+		},
+		GetBlockCountFunc: func(context.Context) (int, error) {
+			c.mu.Lock()
+			defer c.mu.Unlock()
+
+			return c.blockCounts[id], nil
+		},
+		GetBestBlockHashFunc: func(context.Context) (string, error) {
+			c.mu.Lock()
+			defer c.mu.Unlock()
+
+			if c.healed && c.bestBlockHashErrors > 0 {
+				c.bestBlockHashErrors--
+
+				return "", errGetBestBlockHash
+			}
+
+			if !c.healed {
+				if tip, ok := c.tips[id]; ok {
+					return tip, nil
+				}
+
+				return "hash" + strconv.Itoa(c.blockCounts[id]), nil
+			}
+
+			var best int
+
+			for _, count := range c.blockCounts {
+				best = max(best, count)
+			}
+
+			return "hash" + strconv.Itoa(best), nil
+		},
+	}
+}
+
+func newPartitionedNetwork(
+	t *testing.T,
+	nodes int,
+	chains *partitionChains,
+	groups ...[]int,
+) (*mockNodes, *privatebtc.Partition) {
+	t.Helper()
+
+	req := require.New(t)
+
+	var newRPCClient func(id int) *mock.RPCClient
+
+	if chains != nil {
+		newRPCClient = chains.rpcClient
+	}
+
+	mocks := newMockNodes(newRPCClient)
+
+	pn, err := privatebtc.NewPrivateNetwork(
+		mocks.nodeService(nodes),
+		mocks.rpcClientFactory(),
+		nodes,
+	)
+	req.NoError(err)
+
+	err = pn.Start(context.Background())
+	req.NoError(err)
+
+	if chains != nil {
+		chains.mu.Lock()
+		chains.healed = false
+		chains.mu.Unlock()
+	}
+
+	p, err := pn.Partition(context.Background(), groups...)
+	req.NoError(err)
+
+	if chains != nil {
+		chains.mu.Lock()
+		chains.partitioned = true
+		chains.mu.Unlock()
+	}
+
+	return mocks, p
+}
+
+func TestPartition(t *testing.T) {
+	t.Parallel()
+
+	t.Run("InvalidGroups", func(t *testing.T) {
+		t.Parallel()
+
+		tests := map[string]struct {
+			groups        [][]int
+			expectedError error
+		}{
+			"SingleGroup": {
+				groups:        [][]int{{0, 1, 2}},
+				expectedError: privatebtc.ErrPartitionNeedsAtLeastTwoGroups,
+			},
+			"EmptyGroup": {
+				groups:        [][]int{{0, 1, 2}, {}},
+				expectedError: privatebtc.ErrEmptyPartitionGroup,
+			},
+			"IndexOutOfRange": {
+				groups:        [][]int{{0, 1}, {2, 3}},
+				expectedError: privatebtc.ErrNodeIndexOutOfRange,
+			},
+			"NodeInMultipleGroups": {
+				groups:        [][]int{{0, 1}, {1, 2}},
+				expectedError: privatebtc.ErrNodeInMultiplePartitionGroups,
+			},
+			"NodeNotInAnyGroup": {
+				groups:        [][]int{{0}, {2}},
+				expectedError: privatebtc.ErrNodeNotInAnyPartitionGroup,
+			},
+		}
+
+		for name, test := range tests {
+			test := test
+
+			t.Run(name, func(t *testing.T) {
+				t.Parallel()
+
+				req := require.New(t)
+
+				const nodes = 3
+
+				mocks := newMockNodes(nil)
+
+				pn, err := privatebtc.NewPrivateNetwork(
+					mocks.nodeService(nodes),
+					mocks.rpcClientFactory(),
+					nodes,
+				)
+				req.NoError(err)
+
+				err = pn.Start(context.Background())
+				req.NoError(err)
+
+				_, err = pn.Partition(context.Background(), test.groups...)
+				req.ErrorIs(err, test.expectedError)
+			})
+		}
+	})
+
+	t.Run("CutsOnlyLinksBetweenGroups", func(t *testing.T) {
+		t.Parallel()
+
+		req := require.New(t)
+
+		mocks, p := newPartitionedNetwork(t, 4, nil, []int{0, 1}, []int{2, 3})
+
+		req.Equal([][2]int{{0, 2}, {0, 3}, {1, 2}, {1, 3}}, mocks.sortedRemoved())
+
+		groups := p.Groups()
+		req.Len(groups, 2)
+		req.Equal(0, groups[0][0].ID())
+		req.Equal(1, groups[0][1].ID())
+		req.Equal(2, groups[1][0].ID())
+		req.Equal(3, groups[1][1].ID())
+
+		_, err := p.Group(2)
+		req.ErrorIs(err, privatebtc.ErrPartitionGroupIndexOutOfRange)
+	})
+
+	t.Run("MineBlocks", func(t *testing.T) {
+		t.Parallel()
+
+		req := require.New(t)
+
+		chains := &partitionChains{blockCounts: map[int]int{}}
+
+		_, p := newPartitionedNetwork(t, 3, chains, []int{0}, []int{1, 2})
+
+		mined := map[int]int64{}
+
+		var mu sync.Mutex
+
+		for _, node := range p.Groups()[1] {
+			id := node.ID()
+
+			node.RPCClient().(*mock.RPCClient).GenerateToAddressFunc = func(
+				_ context.Context,
+				numBlocks int64,
+				_ string,
+			) ([]string, error) {
+				mu.Lock()
+				mined[id] += numBlocks
+				mu.Unlock()
+
+				chains.mu.Lock()
+				chains.blockCounts[1] += int(numBlocks)
+				chains.blockCounts[2] += int(numBlocks)
+				count := chains.blockCounts[id]
+				chains.mu.Unlock()
+
+				return []string{"hash" + strconv.Itoa(count)}, nil
+			}
+		}
+
+		hashes, err := p.MineBlocks(context.Background(), 1, 2)
+		req.NoError(err)
+		req.Equal([]string{"hash2"}, hashes)
+		req.Equal(map[int]int64{1: 2}, mined)
+
+		_, err = p.MineBlocks(context.Background(), 3, 1)
+		req.ErrorIs(err, privatebtc.ErrPartitionGroupIndexOutOfRange)
+		_, err = p.MineBlocks(context.Background(), 1, 0)
+		req.ErrorIs(err, privatebtc.ErrInvalidNumBlocks)
+	})
+
+	t.Run("Heal", func(t *testing.T) {
+		t.Parallel()
+
+		tests := map[string]struct {
+			blockCounts    map[int]int
+			tips           map[int]string
+			expectedWinner int
+			errorAssertion require.ErrorAssertionFunc
+		}{
+			"MinorityWins": {
+				blockCounts:    map[int]int{0: 5, 1: 3, 2: 3},
+				expectedWinner: 0,
+				errorAssertion: require.NoError,
+			},
+			"MajorityWins": {
+				blockCounts:    map[int]int{0: 3, 1: 4, 2: 4},
+				expectedWinner: 1,
+				errorAssertion: require.NoError,
+			},
+			"NotDiverged": {
+				blockCounts:    map[int]int{0: 4, 1: 4, 2: 4},
+				expectedWinner: 0,
+				errorAssertion: require.NoError,
+			},
+			"Tie": {
+				blockCounts:    map[int]int{0: 4, 1: 4, 2: 4},
+				tips:           map[int]string{0: "hash4a", 1: "hash4b", 2: "hash4b"},
+				expectedWinner: -1,
+				errorAssertion: func(t require.TestingT, err error, i ...any) {
+					require.ErrorIs(t, err, privatebtc.ErrPartitionChainsTied, i...)
+				},
+			},
+		}
+
+		for name, test := range tests {
+			test := test
+
+			t.Run(name, func(t *testing.T) {
+				t.Parallel()
+
+				req := require.New(t)
+
+				chains := &partitionChains{blockCounts: test.blockCounts, tips: test.tips}
+
+				mocks, p := newPartitionedNetwork(t, 3, chains, []int{0}, []int{1, 2})
+
+				winner, err := p.Heal(context.Background())
+				test.errorAssertion(t, err)
+				req.Equal(test.expectedWinner, winner)
+
+				req.Equal(
+					[][2]int{{0, 1}, {0, 1}, {0, 2}, {0, 2}, {1, 2}},
+					mocks.sortedAdded(),
+				)
+
+				_, err = p.Heal(context.Background())
+				req.ErrorIs(err, privatebtc.ErrPartitionHealed)
+
+				_, err = p.MineBlocks(context.Background(), 0, 1)
+				req.ErrorIs(err, privatebtc.ErrPartitionHealed)
+			})
+		}
+	})
+
+	t.Run("HealRetry", func(t *testing.T) {
+		t.Parallel()
+
+		req := require.New(t)
+
+		chains := &partitionChains{blockCounts: map[int]int{0: 5, 1: 3, 2: 3}, addPeerErrors: 1}
+
+		_, p := newPartitionedNetwork(t, 3, chains, []int{0}, []int{1, 2})
+
+		_, err := p.Heal(context.Background())
+		req.ErrorIs(err, errAddPeer)
+
+		winner, err := p.Heal(context.Background())
+		req.NoError(err)
+		req.Equal(0, winner)
+	})
+
+	t.Run("HealSyncRetry", func(t *testing.T) {
+		t.Parallel()
+
+		req := require.New(t)
+
+		chains := &partitionChains{blockCounts: map[int]int{0: 3, 1: 5, 2: 5}, bestBlockHashErrors: 1}
+
+		mocks, p := newPartitionedNetwork(t, 3, chains, []int{0}, []int{1, 2})
+
+		_, err := p.Heal(context.Background())
+		req.ErrorIs(err, errGetBestBlockHash)
+
+		added := mocks.sortedAdded()
+
+		winner, err := p.Heal(context.Background())
+		req.NoError(err)
+		req.Equal(1, winner)
+
+		// the retry only syncs the nodes, the links are not restored again.
+		req.Equal(added, mocks.sortedAdded())
+
+		_, err = p.Heal(context.Background())
+		req.ErrorIs(err, privatebtc.ErrPartitionHealed)
+	})
+}
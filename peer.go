@@ -0,0 +1,78 @@
+package privatebtc
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+)
+
+// Peer is a peer connected to a node, as returned by the getpeerinfo RPC.
+type Peer struct {
+	// ID is the peer ID assigned by the node.
+	ID int
+	// Addr is the IP address and port of the peer.
+	Addr    string
+	Inbound bool
+	// ConnectionType is the type of the connection, e.g. manual, inbound or
+	// outbound-full-relay. Requires Bitcoin Core v21 or newer.
+	ConnectionType string
+	// StartingHeight is the block height of the peer when the connection was established.
+	StartingHeight int
+	// SyncedHeaders is the height of the last header in common with the peer, -1 if unknown.
+	SyncedHeaders int
+	// SyncedBlocks is the height of the last block in common with the peer, -1 if unknown.
+	SyncedBlocks int
+	// PingTime is the last ping round trip time, zero until the first pong.
+	PingTime      time.Duration
+	BytesSent     uint64
+	BytesReceived uint64
+	// Services is the hex encoded service flags offered by the peer.
+	Services string
+	// ServicesNames are the names of the services offered by the peer, e.g. NETWORK or WITNESS.
+	ServicesNames []string
+	// Version is the protocol version of the peer.
+	Version int
+	// SubVersion is the user agent of the peer, e.g. /Satoshi:26.0.0/.
+	SubVersion string
+}
+
+// IP returns the IP address of the peer, without the port.
+func (p Peer) IP() string {
+	host, _, err := net.SplitHostPort(p.Addr)
+	if err != nil {
+		return p.Addr
+	}
+
+	return host
+}
+
+// ConnectedPeers returns the nodes of the private network the node is connected to,
+// along with their peer info, indexed by node ID.
+// Unlike GetConnectionCount, the connections to nodes outside the network are ignored.
+func (n Node) ConnectedPeers(ctx context.Context) (map[int]Peer, error) {
+	peers, err := n.rpcClient.GetPeerInfo(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get peer info: %w", err)
+	}
+
+	connected := map[int]Peer{}
+
+	for _, node := range n.pn.Nodes() {
+		if node.id == n.id {
+			continue
+		}
+
+		ip := node.NodeHandler().InternalIP()
+
+		for _, peer := range peers {
+			if peer.IP() == ip {
+				connected[node.id] = peer
+
+				break
+			}
+		}
+	}
+
+	return connected, nil
+}
@@ -0,0 +1,76 @@
+package privatebtc_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/adrianbrad/privatebtc"
+	"github.com/adrianbrad/privatebtc/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeerIP(t *testing.T) {
+	t.Parallel()
+
+	req := require.New(t)
+
+	req.Equal("172.17.0.2", privatebtc.Peer{Addr: "172.17.0.2:18444"}.IP())
+	req.Equal("::1", privatebtc.Peer{Addr: "[::1]:18444"}.IP())
+	req.Equal("172.17.0.2", privatebtc.Peer{Addr: "172.17.0.2"}.IP())
+}
+
+func TestNodeConnectedPeers(t *testing.T) {
+	t.Parallel()
+
+	req := require.New(t)
+
+	mocks := newMockNodes(func(int) *mock.RPCClient {
+		return &mock.RPCClient{
+			GetPeerInfoFunc: func(context.Context) ([]privatebtc.Peer, error) {
+				return []privatebtc.Peer{
+					{ID: 3, Addr: "172.17.0.10:18444", Inbound: true, PingTime: time.Millisecond},
+					{ID: 4, Addr: "172.17.0.1:18444", SyncedBlocks: 101},
+					{ID: 5, Addr: "10.0.0.1:18444"},
+				}, nil
+			},
+		}
+	})
+
+	handlers := make([]privatebtc.NodeHandler, 3)
+
+	for i := range handlers {
+		port := strconv.Itoa(i)
+		ip := "172.17.0." + strconv.Itoa(i)
+
+		handlers[i] = &mock.NodeHandler{
+			HostRPCPortFunc: func() string {
+				return port
+			},
+			InternalIPFunc: func() string {
+				return ip
+			},
+			NameFunc: func() string {
+				return nodeName
+			},
+		}
+	}
+
+	pn, err := privatebtc.NewPrivateNetwork(
+		newPrivateNetworkStartSuccessDockerService(handlers...),
+		mocks.rpcClientFactory(),
+		3,
+	)
+	req.NoError(err)
+
+	req.NoError(pn.Start(context.Background()))
+
+	peers, err := pn.Nodes()[0].ConnectedPeers(context.Background())
+	req.NoError(err)
+
+	// node 2 is not matched by the 172.17.0.10 peer, nor is the node outside the network reported.
+	req.Equal(map[int]privatebtc.Peer{
+		1: {ID: 4, Addr: "172.17.0.1:18444", SyncedBlocks: 101},
+	}, peers)
+}
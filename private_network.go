@@ -5,8 +5,12 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
-	"time"
+	"path/filepath"
+	"strconv"
+	"sync"
 
+	"github.com/avast/retry-go"
+	"golang.org/x/exp/maps"
 	"golang.org/x/exp/slices"
 )
 
@@ -16,19 +20,41 @@ type NodeService interface {
 		ctx context.Context,
 		nodeRequests []CreateNodeRequest,
 	) ([]NodeHandler, error)
+
+	// ListNodes returns the running nodes of the network with the given ID,
+	// indexed by node ID.
+	ListNodes(ctx context.Context, networkID string) (map[int]NodeHandler, error)
+
+	// RemoveNetwork removes the resources shared by the nodes of the network with the
+	// given ID, e.g. the docker network. It is called once every node is terminated.
+	RemoveNetwork(ctx context.Context, networkID string) error
 }
 
 // PrivateNetwork is a Bitcoin private network.
 type PrivateNetwork struct {
-	logger           *slog.Logger
-	nodeService      NodeService
-	rpcClientFactory RPCClientFactory
-	nodes            Nodes
-	nodeRequests     []CreateNodeRequest
-	timeout          *time.Duration
-	walletName       *string
-	rpcUser          string
-	rpcPassword      string
+	id                   string
+	logger               *slog.Logger
+	nodeService          NodeService
+	rpcClientFactory     RPCClientFactory
+	nodes                Nodes
+	nodeRequests         []CreateNodeRequest
+	peers                peerGraph
+	nextNodeID           int
+	timeouts             timeouts
+	walletName           *string
+	bitcoinClientVersion string
+	nodeWallets          map[int]*string
+	snapshotName         string
+	snapshotsDir         string
+	snapshotWallets      map[int]string
+	nodeNamePrefix       string
+	rpcAuth              string
+	fallbackFee          float64
+	rpcUser              string
+	rpcPassword          string
+	clock                *Clock
+
+	mu sync.RWMutex // guards nodes, peers, nodeWallets and nextNodeID
 }
 
 // Default Bitcoin Core ports for regtest.
@@ -50,74 +76,263 @@ func NewPrivateNetwork(
 		opts[i].apply(options)
 	}
 
+	links, err := options.topology.links(nodes)
+	if err != nil {
+		return nil, fmt.Errorf("resolve topology: %w", err)
+	}
+
+	for index := range options.nodeOptions {
+		if index < 0 || index >= nodes {
+			return nil, fmt.Errorf("node options for index %d: %w", index, ErrNodeIndexOutOfRange)
+		}
+	}
+
+	pn, err := newPrivateNetwork(nodeService, rpcClientFactory, options)
+	if err != nil {
+		return nil, err
+	}
+
+	pn.nodeRequests = make([]CreateNodeRequest, nodes)
+	pn.peers = newPeerGraph(nodes, links)
+	pn.nextNodeID = nodes
+
+	for i := range pn.nodeRequests {
+		nodeOpts, err := newNodeOptions(
+			options.bitcoinClientVersion,
+			options.walletName,
+			options.nodeOptions[i],
+		)
+		if err != nil {
+			return nil, fmt.Errorf("node %d options: %w", i, err)
+		}
+
+		pn.nodeRequests[i] = pn.newNodeRequest(i, nodeOpts)
+		pn.nodeWallets[i] = nodeOpts.walletName
+
+		if pn.snapshotName != "" {
+			pn.nodeRequests[i].SnapshotPath = snapshotNodeArchive(filepath.Join(pn.snapshotsDir, pn.snapshotName), i)
+		}
+	}
+
+	return pn, nil
+}
+
+// newPrivateNetwork creates a private network without any nodes.
+func newPrivateNetwork(
+	nodeService NodeService,
+	rpcClientFactory RPCClientFactory,
+	options *options,
+) (*PrivateNetwork, error) {
 	rpcAuth, err := newRPCAuth(options.rpcUser, options.rpcPass)
 	if err != nil {
 		return nil, fmt.Errorf("new rpc auth: %w", err)
 	}
 
-	nodeRequests := make([]CreateNodeRequest, nodes)
+	networkID := options.networkID
+
+	if networkID == "" {
+		if networkID, err = generateNetworkID(); err != nil {
+			return nil, fmt.Errorf("new network id: %w", err)
+		}
+	}
+
+	pn := &PrivateNetwork{
+		id:                   networkID,
+		logger:               slog.New(options.handler),
+		nodeService:          nodeService,
+		rpcClientFactory:     rpcClientFactory,
+		nodes:                nil,
+		nodeRequests:         nil,
+		peers:                peerGraph{},
+		nextNodeID:           0,
+		timeouts:             options.timeouts(),
+		walletName:           options.walletName,
+		bitcoinClientVersion: options.bitcoinClientVersion,
+		nodeWallets:          map[int]*string{},
+		snapshotName:         options.snapshot,
+		snapshotsDir:         options.snapshotsDir,
+		snapshotWallets:      nil,
+		nodeNamePrefix:       options.nodeNamePrefix,
+		rpcAuth:              rpcAuth,
+		fallbackFee:          options.fallbackFee,
+		rpcUser:              options.rpcUser,
+		rpcPassword:          options.rpcPass,
+		clock:                nil,
+	}
+
+	pn.clock = newClock(pn.Nodes, pn.logger)
+
+	return pn, nil
+}
+
+// Attach rebuilds the private network with the given ID from its running nodes,
+// e.g. a network started by a process which has since exited.
+// The options should match the ones the network was created with, the RPC
+// credentials are required in order to connect to the nodes, and the topology
+// describes how the attached nodes, ordered by ID, are connected to each other.
+// The nodes wallets are expected to be loaded already.
+func Attach(
+	ctx context.Context,
+	nodeService NodeService,
+	rpcClientFactory RPCClientFactory,
+	networkID string,
+	opts ...Option,
+) (*PrivateNetwork, error) {
+	options := defaultOptions()
+
+	for i := range opts {
+		opts[i].apply(options)
+	}
+
+	options.networkID = networkID
+
+	pn, err := newPrivateNetwork(nodeService, rpcClientFactory, options)
+	if err != nil {
+		return nil, err
+	}
+
+	pn.logger.Info("📎⌛ Attaching to network", "network_id", networkID)
+
+	nodeHandlers, err := nodeService.ListNodes(ctx, networkID)
+	if err != nil {
+		return nil, fmt.Errorf("list nodes: %w", err)
+	}
+
+	if len(nodeHandlers) == 0 {
+		return nil, fmt.Errorf("network %q: %w", networkID, ErrNetworkNotFound)
+	}
+
+	ids := maps.Keys(nodeHandlers)
+
+	slices.Sort(ids)
+
+	links, err := options.topology.links(len(ids))
+	if err != nil {
+		return nil, fmt.Errorf("resolve topology: %w", err)
+	}
+
+	for _, l := range links {
+		pn.peers.connect(ids[l.from], ids[l.to])
+	}
+
+	pn.nodes = make(Nodes, len(ids))
+
+	for i, id := range ids {
+		if pn.peers[id] == nil {
+			pn.peers[id] = map[int]struct{}{}
+		}
 
-	for i := range nodeRequests {
-		nodeRequests[i] = CreateNodeRequest{
-			RPCAuth:     rpcAuth,
-			FallbackFee: options.fallbackFee,
+		rpcClient, err := rpcClientFactory.NewRPCClient(
+			ctx,
+			nodeHandlers[id].HostRPCPort(),
+			options.rpcUser,
+			options.rpcPass,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("new rpc client for node %d: %w", id, err)
 		}
+
+		pn.nodes[i] = pn.node(id, rpcClient, nodeHandlers[id])
+		pn.nodeWallets[id] = options.walletName
 	}
 
-	return &PrivateNetwork{
-		logger:           slog.New(options.handler),
-		nodeService:      nodeService,
-		rpcClientFactory: rpcClientFactory,
-		nodes:            nil,
-		nodeRequests:     nodeRequests,
-		timeout:          options.timeout,
-		walletName:       options.walletName,
-		rpcUser:          options.rpcUser,
-		rpcPassword:      options.rpcPass,
-	}, nil
+	pn.nextNodeID = ids[len(ids)-1] + 1
+
+	pn.logger.Info("📎✅ Successfully attached to network", "network_id", networkID, "nodes", len(ids))
+
+	return pn, nil
+}
+
+// ID returns the ID of the private network, the nodes of the network are labelled
+// with it.
+func (n *PrivateNetwork) ID() string {
+	return n.id
+}
+
+func (n *PrivateNetwork) newNodeRequest(id int, nodeOpts *nodeOptions) CreateNodeRequest {
+	return nodeOpts.request(CreateNodeRequest{
+		Name:        fmt.Sprintf("%s%s_%d", n.nodeNamePrefix, n.id, id),
+		NetworkID:   n.id,
+		NodeID:      id,
+		RPCAuth:     n.rpcAuth,
+		FallbackFee: n.fallbackFee,
+	})
 }
 
 // Start creates the private network nodes and connects them.
+// Start is all or nothing, if it fails after the nodes are created every created node
+// is terminated and the error of every failed step is returned.
 func (n *PrivateNetwork) Start(ctx context.Context) error {
+	if len(n.Nodes()) != 0 {
+		return ErrNetworkAlreadyStarted
+	}
+
+	if n.snapshotName != "" {
+		manifest, err := loadSnapshotManifest(filepath.Join(n.snapshotsDir, n.snapshotName), len(n.nodeRequests))
+		if err != nil {
+			return fmt.Errorf("load snapshot %q: %w", n.snapshotName, err)
+		}
+
+		n.snapshotWallets = manifest.Wallets
+	}
+
 	n.logger.Info("⌛ Creating nodes")
 
-	nodes, err := n.nodeService.CreateNodes(ctx, n.nodeRequests)
+	nodeHandlers, err := n.createNodes(ctx, n.nodeRequests)
 	if err != nil {
 		return fmt.Errorf("create nodes: %w", err)
 	}
 
 	n.logger.Info("🐳✅ Successfully created nodes")
 
-	n.nodes = make([]Node, len(nodes))
+	if err := n.startNodes(ctx, nodeHandlers); err != nil {
+		n.logger.Info("↩️⌛ Rolling back network start")
 
-	for i, nodeHandler := range nodes {
-		rpcClient, err := n.rpcClientFactory.NewRPCClient(
-			nodeHandler.HostRPCPort(),
-			n.rpcUser,
-			n.rpcPassword,
-		)
-		if err != nil {
-			return fmt.Errorf("new rpc client: %w", err)
+		if rollbackErr := n.rollbackStart(nodeHandlers); rollbackErr != nil {
+			return errors.Join(err, fmt.Errorf("rollback: %w", rollbackErr))
 		}
 
-		if n.walletName != nil {
-			if err := rpcClient.CreateWallet(ctx, *n.walletName); err != nil {
-				return fmt.Errorf("create wallet: %w", err)
-			}
-		}
+		n.logger.Info("↩️✅ Successfully rolled back network start")
+
+		return err
+	}
+
+	return nil
+}
+
+// startNodes sets up every created node and connects the nodes to each other.
+// The setup errors of all the nodes are returned joined together.
+func (n *PrivateNetwork) startNodes(ctx context.Context, nodeHandlers []NodeHandler) error {
+	startNodes := make(Nodes, len(nodeHandlers))
+
+	var errs error
 
-		n.nodes[i] = Node{
-			id:          i,
-			name:        fmt.Sprintf("Node %d", i),
-			rpcClient:   rpcClient,
-			nodeHandler: nodeHandler,
-			pn:          n,
+	for i, nodeHandler := range nodeHandlers {
+		node, err := n.newNode(ctx, i, nodeHandler)
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("node %d: %w", i, err))
+
+			continue
 		}
+
+		startNodes[i] = node
+	}
+
+	if errs != nil {
+		return errs
 	}
 
+	n.mu.Lock()
+	n.nodes = startNodes
+	peers := n.peers.clone()
+	n.mu.Unlock()
+
 	n.logger.Info("🔗⌛ Connecting nodes")
 
-	if err := connectNodes(ctx, n.nodes); err != nil {
+	ctxTimeout, cancel := context.WithTimeout(ctx, n.timeouts.connect)
+	defer cancel()
+
+	if err := connectNodes(ctxTimeout, startNodes, peers); err != nil {
 		return fmt.Errorf("connect nodes: %w", err)
 	}
 
@@ -126,26 +341,409 @@ func (n *PrivateNetwork) Start(ctx context.Context) error {
 	return nil
 }
 
+// rollbackStart terminates the nodes created by a failed Start and removes the
+// network, leaving the private network as it was before Start was called.
+func (n *PrivateNetwork) rollbackStart(nodeHandlers []NodeHandler) error {
+	n.mu.Lock()
+	n.nodes = nil
+	n.mu.Unlock()
+
+	var errs error
+
+	for i := range nodeHandlers {
+		if err := nodeHandlers[i].Close(); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("terminate node %d: %w", i, err))
+		}
+	}
+
+	// the start context might be the reason Start failed, the rollback gets its own.
+	if err := n.nodeService.RemoveNetwork(context.Background(), n.id); err != nil {
+		errs = errors.Join(errs, fmt.Errorf("remove network: %w", err))
+	}
+
+	return errs
+}
+
+// createNodes creates the nodes through the node service, bounded by the node
+// creation timeout.
+func (n *PrivateNetwork) createNodes(ctx context.Context, nodeRequests []CreateNodeRequest) ([]NodeHandler, error) {
+	ctxTimeout, cancel := context.WithTimeout(ctx, n.timeouts.createNodes)
+	defer cancel()
+
+	return n.nodeService.CreateNodes(ctxTimeout, nodeRequests)
+}
+
+// newNode creates the RPC client for the given node handler and, if configured,
+// the node wallet, bounded by the RPC warm-up timeout.
+func (n *PrivateNetwork) newNode(ctx context.Context, id int, nodeHandler NodeHandler) (Node, error) {
+	ctx, cancel := context.WithTimeout(ctx, n.timeouts.rpcWarmUp)
+	defer cancel()
+
+	rpcClient, err := n.rpcClientFactory.NewRPCClient(
+		ctx,
+		nodeHandler.HostRPCPort(),
+		n.rpcUser,
+		n.rpcPassword,
+	)
+	if err != nil {
+		return Node{}, fmt.Errorf("new rpc client: %w", err)
+	}
+
+	n.mu.Lock()
+	walletName := n.nodeWallets[id]
+
+	snapshotWallet, fromSnapshot := n.snapshotWallets[id]
+	if fromSnapshot && walletName == nil {
+		walletName = &snapshotWallet
+		n.nodeWallets[id] = walletName
+	}
+	n.mu.Unlock()
+
+	switch {
+	case walletName == nil:
+	case fromSnapshot && *walletName == snapshotWallet:
+		if err := rpcClient.LoadWallet(ctx, *walletName); err != nil {
+			return Node{}, fmt.Errorf("load wallet: %w", err)
+		}
+	default:
+		if err := rpcClient.CreateWallet(ctx, *walletName); err != nil {
+			return Node{}, fmt.Errorf("create wallet: %w", err)
+		}
+	}
+
+	return n.node(id, rpcClient, nodeHandler), nil
+}
+
+func (n *PrivateNetwork) node(id int, rpcClient RPCClient, nodeHandler NodeHandler) Node {
+	return Node{
+		id:          id,
+		name:        fmt.Sprintf("Node %d", id),
+		rpcClient:   rpcClient,
+		nodeHandler: nodeHandler,
+		pn:          n,
+	}
+}
+
+// peersOf returns the nodes that are peers of the node with the given ID,
+// as described by the network topology.
+func (n *PrivateNetwork) peersOf(id int) Nodes {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+
+	peerIDs := n.peers.peers(id)
+
+	peers := make(Nodes, 0, len(peerIDs))
+
+	for _, node := range n.nodes {
+		if slices.Contains(peerIDs, node.id) {
+			peers = append(peers, node)
+		}
+	}
+
+	return peers
+}
+
+// snapshot returns a consistent copy of the network nodes and their peer graph.
+func (n *PrivateNetwork) snapshot() (Nodes, peerGraph) {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+
+	return slices.Clone(n.nodes), n.peers.clone()
+}
+
 // Nodes returns a copy of the nodes in the private network.
 func (n *PrivateNetwork) Nodes() Nodes {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+
 	return slices.Clone(n.nodes)
 }
 
+// AddNode creates a new node through the node service and connects it to the running
+// private network. By default, the node is peered with every node in the network,
+// use WithNodePeers to choose its peers.
+// The method waits until the new node is synced to the best block of its peers.
+// nolint: funlen
+func (n *PrivateNetwork) AddNode(ctx context.Context, opts ...AddNodeOption) (_ Node, err error) {
+	options := &addNodeOptions{peers: nil, node: nil}
+
+	for i := range opts {
+		opts[i].applyAddNode(options)
+	}
+
+	nodeOpts, err := newNodeOptions(n.bitcoinClientVersion, n.walletName, options.node)
+	if err != nil {
+		return Node{}, fmt.Errorf("node options: %w", err)
+	}
+
+	n.mu.Lock()
+	id := n.nextNodeID
+	n.nextNodeID++
+	n.nodeWallets[id] = nodeOpts.walletName
+	nodes := slices.Clone(n.nodes)
+	n.mu.Unlock()
+
+	defer func() {
+		if err != nil {
+			n.mu.Lock()
+			delete(n.nodeWallets, id)
+			n.mu.Unlock()
+		}
+	}()
+
+	peerIDs := options.peers
+
+	if peerIDs == nil {
+		peerIDs = make([]int, len(nodes))
+
+		for i := range nodes {
+			peerIDs[i] = nodes[i].id
+		}
+	}
+
+	peers := make(Nodes, 0, len(peerIDs))
+
+	for _, peerID := range peerIDs {
+		i := slices.IndexFunc(nodes, func(node Node) bool { return node.id == peerID })
+		if i == -1 {
+			return Node{}, fmt.Errorf("peer %d: %w", peerID, ErrNodeNotFound)
+		}
+
+		peers = append(peers, nodes[i])
+	}
+
+	n.logger.Info("➕⌛ Adding node", "node_id", id, "peers", peerIDs)
+
+	nodeHandlers, err := n.createNodes(ctx, []CreateNodeRequest{n.newNodeRequest(id, nodeOpts)})
+	if err != nil {
+		return Node{}, fmt.Errorf("create node: %w", err)
+	}
+
+	node, err := n.newNode(ctx, id, nodeHandlers[0])
+	if err != nil {
+		return Node{}, errors.Join(err, nodeHandlers[0].Close())
+	}
+
+	n.mu.Lock()
+	n.nodes = append(n.nodes, node)
+	n.peers[id] = map[int]struct{}{}
+
+	for _, peer := range peers {
+		n.peers.connect(id, peer.id)
+	}
+	n.mu.Unlock()
+
+	// a node behind the network time rejects the blocks timestamped in its future.
+	if err := n.clock.syncNode(ctx, node); err != nil {
+		return Node{}, errors.Join(fmt.Errorf("sync clock: %w", err), n.removeNode(node))
+	}
+
+	if err := n.joinNetwork(ctx, node, peers); err != nil {
+		return Node{}, errors.Join(err, n.removeNode(node))
+	}
+
+	n.logger.Info("➕✅ Successfully added node", "node_id", node.Name())
+
+	return node, nil
+}
+
+// joinNetwork connects the node to its peers and waits for the initial block download.
+func (n *PrivateNetwork) joinNetwork(ctx context.Context, node Node, peers Nodes) error {
+	if err := n.connectToPeers(ctx, node, peers); err != nil {
+		return err
+	}
+
+	if len(peers) == 0 {
+		return nil
+	}
+
+	bestBlockHash, err := peers[0].RPCClient().GetBestBlockHash(ctx)
+	if err != nil {
+		return fmt.Errorf("get best block hash for node %d: %w", peers[0].id, err)
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, n.timeouts.sync)
+	defer cancel()
+
+	if err := (Nodes{node}).Sync(ctxTimeout, bestBlockHash); err != nil {
+		return fmt.Errorf("initial block download: %w", err)
+	}
+
+	return nil
+}
+
+// connectToPeers connects the node to its peers and waits for the connections to be
+// established, bounded by the peer connection timeout.
+func (n *PrivateNetwork) connectToPeers(ctx context.Context, node Node, peers Nodes) error {
+	ctx, cancel := context.WithTimeout(ctx, n.timeouts.connect)
+	defer cancel()
+
+	if err := node.ConnectToNetwork(ctx); err != nil {
+		return fmt.Errorf("connect to network: %w", err)
+	}
+
+	const attempts = 5
+
+	if err := retry.Do(func() error {
+		peerCount, err := node.RPCClient().GetConnectionCount(ctx)
+		if err != nil {
+			return fmt.Errorf("get connection count for node %d: %w", node.id, err)
+		}
+
+		if peerCount != len(peers) {
+			return &UnexpectedPeerCountError{
+				nodeName: node.Name(),
+				expected: len(peers),
+				got:      peerCount,
+			}
+		}
+
+		return nil
+	}, retry.Context(ctx), retry.Attempts(attempts)); err != nil {
+		return fmt.Errorf("wait for peers: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveNode disconnects the node with the given ID from its peers, terminates it
+// and removes it from the private network.
+func (n *PrivateNetwork) RemoveNode(ctx context.Context, id int) error {
+	node, err := n.nodeByID(id)
+	if err != nil {
+		return err
+	}
+
+	n.logger.Info("➖⌛ Removing node", "node_id", node.Name())
+
+	if err := node.DisconnectFromNetwork(ctx); err != nil {
+		return fmt.Errorf("disconnect from network: %w", err)
+	}
+
+	if err := n.removeNode(node); err != nil {
+		return err
+	}
+
+	n.logger.Info("➖✅ Successfully removed node", "node_id", node.Name())
+
+	return nil
+}
+
+// removeNode removes the node from the network nodes and peer graph and terminates it.
+func (n *PrivateNetwork) removeNode(node Node) error {
+	n.mu.Lock()
+	n.nodes = slices.DeleteFunc(n.nodes, func(nd Node) bool { return nd.id == node.id })
+	n.peers.remove(node.id)
+	delete(n.nodeWallets, node.id)
+	n.mu.Unlock()
+
+	if err := node.nodeHandler.Close(); err != nil {
+		return fmt.Errorf("terminate node %d: %w", node.id, err)
+	}
+
+	return nil
+}
+
+// Labels set on every node, they identify the nodes of a network.
+const (
+	NetworkIDLabel = "privatebtc.network.id"
+	NodeIDLabel    = "privatebtc.node.id"
+)
+
 // CreateNodeRequest is used to create a node.
 type CreateNodeRequest struct {
+	Name        string
+	NetworkID   string
+	NodeID      int
 	RPCAuth     string
 	FallbackFee float64
+	// RPCAllowIP is the subnet RPC requests are allowed from, set by the node service
+	// to the subnet of the network the node runs in.
+	// Defaults to the docker default bridge network subnet.
+	RPCAllowIP string
+
+	// BitcoinClientVersion is the version of the bitcoin client the node runs,
+	// empty for the latest version.
+	BitcoinClientVersion string
+	// Prune is the bitcoind -prune target in MiB, 0 disables pruning.
+	Prune            uint
+	TxIndex          bool
+	BlockFilterIndex bool
+	CoinStatsIndex   bool
+	// Args are extra arguments appended to the bitcoind command line.
+	Args []string
+	// SnapshotPath is the path of a data directory tar archive, exported by
+	// NodeHandler.ExportDatadir, restored before the node starts.
+	// Empty if the node starts from genesis.
+	SnapshotPath string
+}
+
+// Labels returns the labels identifying the node and its network.
+func (r CreateNodeRequest) Labels() map[string]string {
+	return map[string]string{
+		NetworkIDLabel: r.NetworkID,
+		NodeIDLabel:    strconv.Itoa(r.NodeID),
+	}
+}
+
+// BitcoindArgs returns the bitcoind command line arguments for the node.
+func (r CreateNodeRequest) BitcoindArgs() []string {
+	rpcAllowIP := r.RPCAllowIP
+
+	if rpcAllowIP == "" {
+		rpcAllowIP = "172.17.0.0/16"
+	}
+
+	args := []string{
+		"-regtest=1",
+		fmt.Sprintf("-rpcallowip=%s", rpcAllowIP), // allow requests coming from the docker host
+		"-rpcbind=0.0.0.0",
+		"-dnsseed=0",
+		fmt.Sprintf("-rpcauth=%s", r.RPCAuth),
+		fmt.Sprintf("-fallbackfee=%f", r.FallbackFee),
+		// "blocksonly=1", // use this flag in order to disable mempool and
+		// cause walletnotify to trigger when transaction has only 1 confirmation
+	}
+
+	if r.TxIndex {
+		args = append(args, "-txindex")
+	}
+
+	if r.Prune != 0 {
+		args = append(args, fmt.Sprintf("-prune=%d", r.Prune))
+	}
+
+	if r.BlockFilterIndex {
+		args = append(args, "-blockfilterindex")
+	}
+
+	if r.CoinStatsIndex {
+		args = append(args, "-coinstatsindex")
+	}
+
+	return append(args, r.Args...)
 }
 
-// Close terminates all nodes in the private network.
+// Close terminates all nodes in the private network and removes the network.
 func (n *PrivateNetwork) Close() error {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+
 	var errs error
 
 	for i := range n.nodes {
 		if err := n.nodes[i].nodeHandler.Close(); err != nil {
-			errs = errors.Join(errs, fmt.Errorf("terminate node %d: %w", i, err))
+			errs = errors.Join(errs, fmt.Errorf("terminate node %d: %w", n.nodes[i].id, err))
 		}
 	}
 
-	return errs
+	if errs != nil {
+		return errs
+	}
+
+	if err := n.nodeService.RemoveNetwork(context.Background(), n.id); err != nil {
+		return fmt.Errorf("remove network: %w", err)
+	}
+
+	return nil
 }
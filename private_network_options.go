@@ -3,6 +3,8 @@ package privatebtc
 import (
 	"io"
 	"log/slog"
+	"os"
+	"path/filepath"
 	"time"
 )
 
@@ -14,7 +16,13 @@ type options struct {
 	bitcoinClientVersion string
 	nodeNamePrefix       string
 	timeout              *time.Duration
+	operationTimeouts    map[timeoutOperation]time.Duration
 	handler              slog.Handler
+	topology             Topology
+	nodeOptions          map[int][]NodeOption
+	snapshot             string
+	snapshotsDir         string
+	networkID            string
 }
 
 func defaultOptions() *options {
@@ -24,9 +32,15 @@ func defaultOptions() *options {
 		fallbackFee:          0.01,
 		walletName:           nil,
 		bitcoinClientVersion: "latest",
-		nodeNamePrefix:       "node_",
+		nodeNamePrefix:       "privatebtc_node_",
 		timeout:              nil,
+		operationTimeouts:    map[timeoutOperation]time.Duration{},
 		handler:              slog.NewTextHandler(io.Discard, nil),
+		topology:             FullMeshTopology(),
+		nodeOptions:          map[int][]NodeOption{},
+		snapshot:             "",
+		snapshotsDir:         filepath.Join(os.TempDir(), "privatebtc", "snapshots"),
+		networkID:            "",
 	}
 }
 
@@ -44,6 +58,8 @@ func (v bitcoinClientVersion) apply(opts *options) {
 }
 
 // WithBitcoinClientVersion configures the version of the bitcoin client to use.
+// The version is the tag of the bitcoin node docker Image, e.g. "25.0",
+// by default the latest version is used.
 func WithBitcoinClientVersion(version string) Option {
 	return bitcoinClientVersion(version)
 }
@@ -67,11 +83,53 @@ func (w withTimeout) apply(opts *options) {
 	opts.timeout = (*time.Duration)(&w)
 }
 
-// WithTimeout configures the timeout for the docker operations.
+// WithTimeout configures the timeout for every private network operation: node
+// creation, RPC warm-up, peer connection, sync and the chain reorg assertions.
+// The operation specific options take precedence over it.
 func WithTimeout(timeout time.Duration) Option {
 	return withTimeout(timeout)
 }
 
+type withOperationTimeout struct {
+	op      timeoutOperation
+	timeout time.Duration
+}
+
+func (w withOperationTimeout) apply(opts *options) {
+	opts.operationTimeouts[w.op] = w.timeout
+}
+
+// WithCreateNodesTimeout configures the timeout for creating the nodes through the
+// node service. Defaults to 2 minutes.
+func WithCreateNodesTimeout(timeout time.Duration) Option {
+	return withOperationTimeout{op: createNodesTimeout, timeout: timeout}
+}
+
+// WithRPCWarmUpTimeout configures the timeout, applied to every node individually,
+// for creating the node RPC client and wallet. Defaults to 30 seconds.
+func WithRPCWarmUpTimeout(timeout time.Duration) Option {
+	return withOperationTimeout{op: rpcWarmUpTimeout, timeout: timeout}
+}
+
+// WithConnectTimeout configures the timeout for connecting the nodes to their peers.
+// Defaults to 30 seconds.
+func WithConnectTimeout(timeout time.Duration) Option {
+	return withOperationTimeout{op: connectTimeout, timeout: timeout}
+}
+
+// WithSyncTimeout configures the timeout for waiting on the nodes to sync to a block,
+// e.g. after mining blocks during a chain reorg or healing a partition.
+// Defaults to 5 seconds.
+func WithSyncTimeout(timeout time.Duration) Option {
+	return withOperationTimeout{op: syncTimeout, timeout: timeout}
+}
+
+// WithAssertionTimeout configures the timeout for the peer count checks performed
+// by ChainReorgWithAssertion. Defaults to 1 second.
+func WithAssertionTimeout(timeout time.Duration) Option {
+	return withOperationTimeout{op: assertionTimeout, timeout: timeout}
+}
+
 type withNodeNamePrefix string
 
 func (w withNodeNamePrefix) apply(opts *options) {
@@ -79,6 +137,8 @@ func (w withNodeNamePrefix) apply(opts *options) {
 }
 
 // WithNodeNamePrefix configures the prefix for the node names.
+// The node names are made unique by appending the network ID and the node ID to
+// the prefix.
 func WithNodeNamePrefix(nodeNamePrefix string) Option {
 	return withNodeNamePrefix(nodeNamePrefix)
 }
@@ -113,3 +173,112 @@ func (w withSlogHandler) apply(opts *options) {
 func WithSlogHandler(handler slog.Handler) Option {
 	return withSlogHandler{handler: handler}
 }
+
+type withTopology struct {
+	topology Topology
+}
+
+func (w withTopology) apply(opts *options) {
+	if w.topology == nil {
+		return
+	}
+
+	opts.topology = w.topology
+}
+
+// WithTopology configures how the nodes of the Bitcoin Private Network are connected
+// to each other. By default, every node is connected to every other node.
+// A nil topology keeps the default one.
+func WithTopology(topology Topology) Option {
+	return withTopology{topology: topology}
+}
+
+type withNetworkID string
+
+func (w withNetworkID) apply(opts *options) {
+	opts.networkID = string(w)
+}
+
+// WithNetworkID configures the ID of the Bitcoin Private Network, which is used to
+// label its nodes and to attach to the network later on.
+// By default, a random ID is generated.
+func WithNetworkID(networkID string) Option {
+	return withNetworkID(networkID)
+}
+
+type withSnapshot string
+
+func (w withSnapshot) apply(opts *options) {
+	opts.snapshot = string(w)
+}
+
+// WithSnapshot configures the Bitcoin Private Network to start from the snapshot
+// with the given name, taken using PrivateNetwork.Snapshot.
+// The snapshot has to be taken from a network with the same number of nodes.
+// The snapshot node wallets are loaded instead of being created, unless the node is
+// configured with a different wallet name.
+func WithSnapshot(name string) Option {
+	return withSnapshot(name)
+}
+
+type withSnapshotsDir string
+
+func (w withSnapshotsDir) apply(opts *options) {
+	opts.snapshotsDir = string(w)
+}
+
+// WithSnapshotsDir configures the directory where the network snapshots are stored.
+// By default, the snapshots are stored in the privatebtc/snapshots subdirectory of
+// the OS temporary directory.
+func WithSnapshotsDir(dir string) Option {
+	return withSnapshotsDir(dir)
+}
+
+type addNodeOptions struct {
+	peers []int
+	node  []NodeOption
+}
+
+// An AddNodeOption configures a node added to a running Bitcoin Private Network.
+type AddNodeOption interface {
+	applyAddNode(*addNodeOptions)
+}
+
+type withNodePeers []int
+
+func (w withNodePeers) applyAddNode(opts *addNodeOptions) {
+	opts.peers = append([]int{}, w...)
+}
+
+// WithNodePeers configures the IDs of the nodes the added node is connected to.
+// By default, the added node is connected to every node in the network.
+// Calling it without IDs adds the node without any peers.
+func WithNodePeers(ids ...int) AddNodeOption {
+	return withNodePeers(ids)
+}
+
+type withNodeOptions struct {
+	index int
+	opts  []NodeOption
+}
+
+func (w withNodeOptions) apply(opts *options) {
+	opts.nodeOptions[w.index] = append(opts.nodeOptions[w.index], w.opts...)
+}
+
+// WithNodeOptions configures the node with the given index of the Bitcoin Private Network.
+// Nodes without node options are created with the network options.
+func WithNodeOptions(index int, opts ...NodeOption) Option {
+	return withNodeOptions{index: index, opts: opts}
+}
+
+type withAddedNodeOptions []NodeOption
+
+func (w withAddedNodeOptions) applyAddNode(opts *addNodeOptions) {
+	opts.node = append(opts.node, w...)
+}
+
+// WithAddedNodeOptions configures the node added to the network.
+func WithAddedNodeOptions(opts ...NodeOption) AddNodeOption {
+	return withAddedNodeOptions(opts)
+}
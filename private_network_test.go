@@ -3,7 +3,10 @@ package privatebtc_test
 import (
 	"context"
 	"crypto/rand"
+	"errors"
 	"io"
+	"strconv"
+	"sync"
 	"testing"
 	"testing/iotest"
 	"time"
@@ -123,6 +126,7 @@ func TestPrivateNetwork(t *testing.T) {
 				},
 				mockRPCClientFactory: &mock.RPCClientFactory{
 					NewRPCClientFunc: func(
+						_ context.Context,
 						hostRPCPort string,
 						rpcUser string,
 						rpcPass string,
@@ -156,6 +160,7 @@ func TestPrivateNetwork(t *testing.T) {
 				},
 				mockRPCClientFactory: &mock.RPCClientFactory{
 					NewRPCClientFunc: func(
+						_ context.Context,
 						hostRPCPort string,
 						rpcUser string,
 						rpcPass string,
@@ -193,6 +198,7 @@ func TestPrivateNetwork(t *testing.T) {
 				},
 				mockRPCClientFactory: &mock.RPCClientFactory{
 					NewRPCClientFunc: func(
+						_ context.Context,
 						hostRPCPort string,
 						rpcUser string,
 						rpcPass string,
@@ -238,6 +244,7 @@ func TestPrivateNetwork(t *testing.T) {
 				},
 				mockRPCClientFactory: &mock.RPCClientFactory{
 					NewRPCClientFunc: func(
+						_ context.Context,
 						hostRPCPort string,
 						rpcUser string,
 						rpcPass string,
@@ -291,6 +298,7 @@ func TestPrivateNetwork(t *testing.T) {
 				},
 				mockRPCClientFactory: &mock.RPCClientFactory{
 					NewRPCClientFunc: func(
+						_ context.Context,
 						hostRPCPort string,
 						rpcUser string,
 						rpcPass string,
@@ -334,6 +342,7 @@ func TestPrivateNetwork(t *testing.T) {
 				},
 				mockRPCClientFactory: &mock.RPCClientFactory{
 					NewRPCClientFunc: func(
+						_ context.Context,
 						hostRPCPort string,
 						rpcUser string,
 						rpcPass string,
@@ -395,6 +404,7 @@ func TestPrivateNetwork(t *testing.T) {
 				},
 				mockRPCClientFactory: &mock.RPCClientFactory{
 					NewRPCClientFunc: func(
+						_ context.Context,
 						hostRPCPort string,
 						rpcUser string,
 						rpcPass string,
@@ -423,6 +433,83 @@ func TestPrivateNetwork(t *testing.T) {
 					)
 				},
 			},
+			"NewRPCClientErrorForEveryNode": {
+				mockNodeService: &mock.NodeService{
+					CreateNodesFunc: func(
+						ctx context.Context,
+						containerRequests []privatebtc.CreateNodeRequest,
+					) ([]privatebtc.NodeHandler, error) {
+						return []privatebtc.NodeHandler{
+							&mock.NodeHandler{
+								HostRPCPortFunc: func() string {
+									return "1234"
+								},
+							},
+							&mock.NodeHandler{
+								HostRPCPortFunc: func() string {
+									return "1234"
+								},
+							},
+						}, nil
+					},
+				},
+				mockRPCClientFactory: &mock.RPCClientFactory{
+					NewRPCClientFunc: func(
+						_ context.Context,
+						hostRPCPort string,
+						rpcUser string,
+						rpcPass string,
+					) (privatebtc.RPCClient, error) {
+						return nil, assert.AnError
+					},
+				},
+				nodes: 2,
+				opts:  nil,
+				errorAssertion: func(t require.TestingT, err error, i ...any) {
+					require.ErrorContains(t, err, "node 0: new rpc client", i...)
+					require.ErrorContains(t, err, "node 1: new rpc client", i...)
+				},
+			},
+			"RollbackError": {
+				mockNodeService: &mock.NodeService{
+					CreateNodesFunc: func(
+						ctx context.Context,
+						containerRequests []privatebtc.CreateNodeRequest,
+					) ([]privatebtc.NodeHandler, error) {
+						return []privatebtc.NodeHandler{
+							&mock.NodeHandler{
+								HostRPCPortFunc: func() string {
+									return "1234"
+								},
+								CloseFunc: func() error {
+									return assert.AnError
+								},
+							},
+						}, nil
+					},
+				},
+				mockRPCClientFactory: &mock.RPCClientFactory{
+					NewRPCClientFunc: func(
+						_ context.Context,
+						hostRPCPort string,
+						rpcUser string,
+						rpcPass string,
+					) (privatebtc.RPCClient, error) {
+						return &mock.RPCClient{
+							CreateWalletFunc: func(ctx context.Context, walletName string) error {
+								return errors.New("create wallet failure")
+							},
+						}, nil
+					},
+				},
+				nodes: 1,
+				opts:  []privatebtc.Option{privatebtc.WithWallet("test")},
+				errorAssertion: func(t require.TestingT, err error, i ...any) {
+					require.ErrorContains(t, err, "node 0: create wallet: create wallet failure", i...)
+					require.ErrorContains(t, err, "rollback: terminate node 0", i...)
+					require.ErrorIs(t, err, assert.AnError, i...)
+				},
+			},
 			"Success": {
 				mockNodeService: &mock.NodeService{
 					CreateNodesFunc: func(
@@ -467,6 +554,35 @@ func TestPrivateNetwork(t *testing.T) {
 				ctx := context.Background()
 				req := require.New(t)
 
+				var handlers []*mock.NodeHandler
+
+				createNodes := test.mockNodeService.CreateNodesFunc
+
+				// record the created nodes in order to assert they are terminated
+				// when Start fails.
+				test.mockNodeService.CreateNodesFunc = func(
+					ctx context.Context,
+					nodeRequests []privatebtc.CreateNodeRequest,
+				) ([]privatebtc.NodeHandler, error) {
+					nodeHandlers, err := createNodes(ctx, nodeRequests)
+
+					for _, nodeHandler := range nodeHandlers {
+						h := nodeHandler.(*mock.NodeHandler)
+
+						if h.CloseFunc == nil {
+							h.CloseFunc = func() error { return nil }
+						}
+
+						handlers = append(handlers, h)
+					}
+
+					return nodeHandlers, err
+				}
+
+				test.mockNodeService.RemoveNetworkFunc = func(context.Context, string) error {
+					return nil
+				}
+
 				pn, err := privatebtc.NewPrivateNetwork(
 					test.mockNodeService,
 					test.mockRPCClientFactory,
@@ -477,6 +593,18 @@ func TestPrivateNetwork(t *testing.T) {
 
 				err = pn.Start(ctx)
 				test.errorAssertion(t, err)
+
+				rolledBack := err != nil && len(handlers) != 0
+
+				for _, h := range handlers {
+					req.Equal(rolledBack, len(h.CloseCalls()) == 1)
+				}
+
+				req.Equal(rolledBack, len(test.mockNodeService.RemoveNetworkCalls()) == 1)
+
+				if rolledBack {
+					req.Empty(pn.Nodes())
+				}
 			})
 		}
 	})
@@ -508,6 +636,32 @@ func TestPrivateNetwork(t *testing.T) {
 					require.ErrorContains(t, err, "terminate node", i...)
 				},
 			},
+			"RemoveNetworkError": {
+				mockNodeService: &mock.NodeService{
+					CreateNodesFunc: func(
+						ctx context.Context,
+						containerRequests []privatebtc.CreateNodeRequest,
+					) ([]privatebtc.NodeHandler, error) {
+						return []privatebtc.NodeHandler{
+							&mock.NodeHandler{
+								HostRPCPortFunc: func() string {
+									return "1234"
+								},
+								CloseFunc: func() error {
+									return nil
+								},
+							},
+						}, nil
+					},
+					RemoveNetworkFunc: func(context.Context, string) error {
+						return assert.AnError
+					},
+				},
+				errorAssertion: func(t require.TestingT, err error, i ...any) {
+					require.ErrorIs(t, err, assert.AnError, i...)
+					require.ErrorContains(t, err, "remove network", i...)
+				},
+			},
 			"Success": {
 				mockNodeService: &mock.NodeService{
 					CreateNodesFunc: func(
@@ -525,6 +679,9 @@ func TestPrivateNetwork(t *testing.T) {
 							},
 						}, nil
 					},
+					RemoveNetworkFunc: func(context.Context, string) error {
+						return nil
+					},
 				},
 				errorAssertion: require.NoError,
 			},
@@ -558,6 +715,447 @@ func TestPrivateNetwork(t *testing.T) {
 		}
 	})
 
+	t.Run("NodeNames", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		req := require.New(t)
+
+		var (
+			names           []string
+			removedNetworks []string
+		)
+
+		newNetwork := func() *privatebtc.PrivateNetwork {
+			mocks := newMockNodes(nil)
+
+			nodeService := mocks.namedNodeService()
+			createNodes := nodeService.CreateNodesFunc
+
+			nodeService.CreateNodesFunc = func(
+				ctx context.Context,
+				nodeRequests []privatebtc.CreateNodeRequest,
+			) ([]privatebtc.NodeHandler, error) {
+				for _, nodeReq := range nodeRequests {
+					names = append(names, nodeReq.Name)
+				}
+
+				return createNodes(ctx, nodeRequests)
+			}
+
+			nodeService.RemoveNetworkFunc = func(_ context.Context, networkID string) error {
+				removedNetworks = append(removedNetworks, networkID)
+
+				return nil
+			}
+
+			pn, err := privatebtc.NewPrivateNetwork(
+				nodeService,
+				mocks.rpcClientFactory(),
+				2,
+				privatebtc.WithNodeNamePrefix("test_"),
+			)
+			req.NoError(err)
+
+			req.NoError(pn.Start(ctx))
+
+			return pn
+		}
+
+		first, second := newNetwork(), newNetwork()
+
+		req.NotEqual(first.ID(), second.ID())
+		req.Equal(
+			[]string{
+				"test_" + first.ID() + "_0",
+				"test_" + first.ID() + "_1",
+				"test_" + second.ID() + "_0",
+				"test_" + second.ID() + "_1",
+			},
+			names,
+		)
+
+		req.NoError(first.Close())
+		req.NoError(second.Close())
+
+		req.Equal([]string{first.ID(), second.ID()}, removedNetworks)
+	})
+
+	t.Run("NodeOptions", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Requests", func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			req := require.New(t)
+
+			var (
+				mu          sync.Mutex
+				nodeReqs    []privatebtc.CreateNodeRequest
+				walletNames = map[string]string{}
+			)
+
+			mocks := newMockNodes(func(id int) *mock.RPCClient {
+				return &mock.RPCClient{
+					CreateWalletFunc: func(_ context.Context, walletName string) error {
+						mu.Lock()
+						defer mu.Unlock()
+
+						walletNames[strconv.Itoa(id)] = walletName
+
+						return nil
+					},
+				}
+			})
+
+			nodeService := mocks.namedNodeService()
+			createNodes := nodeService.CreateNodesFunc
+
+			nodeService.CreateNodesFunc = func(
+				ctx context.Context,
+				nodeRequests []privatebtc.CreateNodeRequest,
+			) ([]privatebtc.NodeHandler, error) {
+				nodeReqs = append(nodeReqs, nodeRequests...)
+
+				return createNodes(ctx, nodeRequests)
+			}
+
+			pn, err := privatebtc.NewPrivateNetwork(
+				nodeService,
+				mocks.rpcClientFactory(),
+				3,
+				privatebtc.WithWallet("network"),
+				privatebtc.WithBitcoinClientVersion("26.0"),
+				privatebtc.WithNodeOptions(
+					0,
+					privatebtc.WithNodeBitcoinClientVersion("25.0"),
+					privatebtc.WithNodeBlockFilterIndex(true),
+					privatebtc.WithNodeCoinStatsIndex(true),
+				),
+				privatebtc.WithNodeOptions(
+					2,
+					privatebtc.WithNodePrune(550),
+					privatebtc.WithNodeWallet("pruned"),
+					privatebtc.WithNodeArgs("-blocksonly=1"),
+				),
+			)
+			req.NoError(err)
+
+			err = pn.Start(ctx)
+			req.NoError(err)
+
+			req.Len(nodeReqs, 3)
+
+			req.Equal("25.0", nodeReqs[0].BitcoinClientVersion)
+			req.Equal("26.0", nodeReqs[1].BitcoinClientVersion)
+			req.Equal("26.0", nodeReqs[2].BitcoinClientVersion)
+
+			req.Subset(nodeReqs[0].BitcoindArgs(), []string{"-txindex", "-blockfilterindex", "-coinstatsindex"})
+			req.Contains(nodeReqs[1].BitcoindArgs(), "-txindex")
+			req.NotContains(nodeReqs[1].BitcoindArgs(), "-blockfilterindex")
+
+			req.False(nodeReqs[2].TxIndex)
+			req.NotContains(nodeReqs[2].BitcoindArgs(), "-txindex")
+			req.Subset(nodeReqs[2].BitcoindArgs(), []string{"-prune=550", "-blocksonly=1"})
+
+			req.Equal(map[string]string{"0": "network", "1": "network", "2": "pruned"}, walletNames)
+		})
+
+		t.Run("Errors", func(t *testing.T) {
+			t.Parallel()
+
+			tests := map[string]struct {
+				opts          []privatebtc.Option
+				expectedError error
+			}{
+				"IndexOutOfRange": {
+					opts: []privatebtc.Option{
+						privatebtc.WithNodeOptions(2, privatebtc.WithNodeTxIndex(false)),
+					},
+					expectedError: privatebtc.ErrNodeIndexOutOfRange,
+				},
+				"PrunedNodeWithTxIndex": {
+					opts: []privatebtc.Option{
+						privatebtc.WithNodeOptions(
+							1,
+							privatebtc.WithNodePrune(550),
+							privatebtc.WithNodeTxIndex(true),
+						),
+					},
+					expectedError: privatebtc.ErrPrunedNodeWithTxIndex,
+				},
+			}
+
+			for name, test := range tests {
+				test := test
+
+				t.Run(name, func(t *testing.T) {
+					t.Parallel()
+
+					_, err := privatebtc.NewPrivateNetwork(nil, nil, 2, test.opts...)
+					require.ErrorIs(t, err, test.expectedError)
+				})
+			}
+		})
+	})
+
+	t.Run("AddNode", func(t *testing.T) {
+		t.Parallel()
+
+		tests := map[string]struct {
+			opts          []privatebtc.AddNodeOption
+			expectedAdded [][2]int
+			expectedError error
+		}{
+			"ConnectsToEveryNode": {
+				opts:          nil,
+				expectedAdded: [][2]int{{0, 1}, {0, 2}, {1, 2}},
+			},
+			"WithNodePeers": {
+				opts:          []privatebtc.AddNodeOption{privatebtc.WithNodePeers(1)},
+				expectedAdded: [][2]int{{0, 1}, {1, 2}},
+			},
+			"WithoutPeers": {
+				opts:          []privatebtc.AddNodeOption{privatebtc.WithNodePeers()},
+				expectedAdded: [][2]int{{0, 1}},
+			},
+			"PeerNotFound": {
+				opts:          []privatebtc.AddNodeOption{privatebtc.WithNodePeers(5)},
+				expectedAdded: [][2]int{{0, 1}},
+				expectedError: privatebtc.ErrNodeNotFound,
+			},
+		}
+
+		for name, test := range tests {
+			test := test
+
+			t.Run(name, func(t *testing.T) {
+				t.Parallel()
+
+				ctx := context.Background()
+				req := require.New(t)
+
+				mocks := newMockNodes(func(int) *mock.RPCClient {
+					return &mock.RPCClient{
+						GetBestBlockHashFunc: func(context.Context) (string, error) {
+							return "hash", nil
+						},
+					}
+				})
+
+				pn, err := privatebtc.NewPrivateNetwork(
+					mocks.namedNodeService(),
+					mocks.rpcClientFactory(),
+					2,
+				)
+				req.NoError(err)
+
+				err = pn.Start(ctx)
+				req.NoError(err)
+
+				node, err := pn.AddNode(ctx, test.opts...)
+				req.ErrorIs(err, test.expectedError)
+				req.Equal(test.expectedAdded, mocks.sortedAdded())
+
+				if test.expectedError != nil {
+					req.Len(pn.Nodes(), 2)
+
+					return
+				}
+
+				req.Equal(2, node.ID())
+				req.Len(pn.Nodes(), 3)
+				req.Equal(node, pn.Nodes()[2])
+			})
+		}
+	})
+
+	t.Run("RemoveNode", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		req := require.New(t)
+
+		mocks := newMockNodes(nil)
+
+		nodeService := mocks.namedNodeService()
+		createNodes := nodeService.CreateNodesFunc
+
+		// the last node fails to terminate once the network is closed.
+		nodeService.CreateNodesFunc = func(
+			ctx context.Context,
+			nodeRequests []privatebtc.CreateNodeRequest,
+		) ([]privatebtc.NodeHandler, error) {
+			handlers, err := createNodes(ctx, nodeRequests)
+			if err != nil {
+				return nil, err
+			}
+
+			handlers[2].(*mock.NodeHandler).CloseFunc = func() error {
+				return assert.AnError
+			}
+
+			return handlers, nil
+		}
+
+		pn, err := privatebtc.NewPrivateNetwork(
+			nodeService,
+			mocks.rpcClientFactory(),
+			3,
+			privatebtc.WithTopology(privatebtc.LineTopology()),
+		)
+		req.NoError(err)
+
+		err = pn.Start(ctx)
+		req.NoError(err)
+
+		err = pn.RemoveNode(ctx, 1)
+		req.NoError(err)
+
+		req.Equal([][2]int{{0, 1}, {2, 1}}, mocks.sortedRemoved())
+		req.Equal([]int{1}, mocks.closedNodes())
+
+		nodes := pn.Nodes()
+		req.Len(nodes, 2)
+		req.Equal(0, nodes[0].ID())
+		req.Equal(2, nodes[1].ID())
+
+		err = pn.RemoveNode(ctx, 1)
+		req.ErrorIs(err, privatebtc.ErrNodeNotFound)
+
+		err = nodes[0].DisconnectFromNetwork(ctx)
+		req.NoError(err)
+
+		req.Equal([][2]int{{0, 1}, {2, 1}}, mocks.sortedRemoved())
+
+		// errors are labeled with the node ID, not the index of the node in the network.
+		err = pn.Close()
+		req.ErrorIs(err, assert.AnError)
+		req.ErrorContains(err, "terminate node 2")
+	})
+
+	t.Run("Attach", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Success", func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			req := require.New(t)
+
+			mocks := newMockNodes(func(int) *mock.RPCClient {
+				return &mock.RPCClient{
+					GetBestBlockHashFunc: func(context.Context) (string, error) {
+						return "hash", nil
+					},
+				}
+			})
+
+			nodeService := mocks.namedNodeService()
+
+			var labels []map[string]string
+
+			createNodes := nodeService.CreateNodesFunc
+
+			nodeService.CreateNodesFunc = func(
+				ctx context.Context,
+				nodeRequests []privatebtc.CreateNodeRequest,
+			) ([]privatebtc.NodeHandler, error) {
+				for _, nodeReq := range nodeRequests {
+					labels = append(labels, nodeReq.Labels())
+				}
+
+				return createNodes(ctx, nodeRequests)
+			}
+
+			handlers, err := createNodes(ctx, []privatebtc.CreateNodeRequest{
+				{Name: "privatebtc_node_0", NodeID: 0},
+				{Name: "privatebtc_node_2", NodeID: 2},
+			})
+			req.NoError(err)
+
+			nodeService.ListNodesFunc = func(
+				_ context.Context,
+				networkID string,
+			) (map[int]privatebtc.NodeHandler, error) {
+				req.Equal("network", networkID)
+
+				return map[int]privatebtc.NodeHandler{0: handlers[0], 2: handlers[1]}, nil
+			}
+
+			pn, err := privatebtc.Attach(
+				ctx,
+				nodeService,
+				mocks.rpcClientFactory(),
+				"network",
+				privatebtc.WithTopology(privatebtc.LineTopology()),
+			)
+			req.NoError(err)
+
+			req.Equal("network", pn.ID())
+
+			nodes := pn.Nodes()
+			req.Len(nodes, 2)
+			req.Equal(0, nodes[0].ID())
+			req.Equal(2, nodes[1].ID())
+			req.Equal("0", nodes[0].NodeHandler().HostRPCPort())
+			req.Equal("2", nodes[1].NodeHandler().HostRPCPort())
+
+			err = pn.Start(ctx)
+			req.ErrorIs(err, privatebtc.ErrNetworkAlreadyStarted)
+
+			err = nodes[1].DisconnectFromNetwork(ctx)
+			req.NoError(err)
+
+			req.Equal([][2]int{{0, 2}}, mocks.sortedRemoved())
+
+			node, err := pn.AddNode(ctx)
+			req.NoError(err)
+			req.Equal(3, node.ID())
+
+			req.Equal(
+				[]map[string]string{{
+					privatebtc.NetworkIDLabel: "network",
+					privatebtc.NodeIDLabel:    "3",
+				}},
+				labels,
+			)
+		})
+
+		t.Run("NetworkNotFound", func(t *testing.T) {
+			t.Parallel()
+
+			_, err := privatebtc.Attach(
+				context.Background(),
+				&mock.NodeService{
+					ListNodesFunc: func(context.Context, string) (map[int]privatebtc.NodeHandler, error) {
+						return nil, nil
+					},
+				},
+				nil,
+				"network",
+			)
+			require.ErrorIs(t, err, privatebtc.ErrNetworkNotFound)
+		})
+
+		t.Run("ListNodesError", func(t *testing.T) {
+			t.Parallel()
+
+			_, err := privatebtc.Attach(
+				context.Background(),
+				&mock.NodeService{
+					ListNodesFunc: func(context.Context, string) (map[int]privatebtc.NodeHandler, error) {
+						return nil, assert.AnError
+					},
+				},
+				nil,
+				"network",
+			)
+			require.ErrorIs(t, err, assert.AnError)
+		})
+	})
+
 	t.Run("Nodes", func(t *testing.T) {
 		tests := map[string]struct {
 			mockNodeService *mock.NodeService
@@ -664,7 +1262,7 @@ func TestBitcoinPrivateNetwork(t *testing.T) {
 	t.Run("ReplaceByFee", func(t *testing.T) {
 		is := is.New(t)
 
-		h, err := testNode.RPCClient().SendToAddress(ctx, burningAddr, 0.1)
+		h, err := testNode.RPCClient().SendToAddress(ctx, burningAddr, privatebtc.BTC/10, privatebtc.SendOptions{})
 		is.NoErr(err)
 
 		mp, err := testNode.RPCClient().GetRawMempool(ctx)
@@ -695,7 +1293,7 @@ func TestBitcoinPrivateNetwork(t *testing.T) {
 		initialBlockCount, err := testNode.RPCClient().GetBlockCount(ctx)
 		is.NoErr(err)
 
-		t.Logf("balance before mining: %f", initialBalance)
+		t.Logf("balance before mining: %+v", initialBalance)
 
 		_, err = testNode.RPCClient().GenerateToAddress(ctx, 1, burningAddr)
 		is.NoErr(err)
@@ -705,8 +1303,8 @@ func TestBitcoinPrivateNetwork(t *testing.T) {
 
 		expectedBalance := initialBalance
 
-		expectedBalance.Trusted += 50
-		expectedBalance.Immature -= 50
+		expectedBalance.Trusted += 50 * privatebtc.BTC
+		expectedBalance.Immature -= 50 * privatebtc.BTC
 
 		is.Equal(bal, expectedBalance)
 
@@ -726,9 +1324,9 @@ func TestBitcoinPrivateNetwork(t *testing.T) {
 		receiverAddr, err := receiverNode.RPCClient().GetNewAddress(ctx, t.Name())
 		is.NoErr(err)
 
-		const amount = 0.1
+		const amount = privatebtc.BTC / 10
 
-		txHash, err := testNode.RPCClient().SendToAddress(ctx, receiverAddr, amount)
+		txHash, err := testNode.RPCClient().SendToAddress(ctx, receiverAddr, amount, privatebtc.SendOptions{})
 		is.NoErr(err)
 
 		start := time.Now()
@@ -775,12 +1373,12 @@ func TestBitcoinPrivateNetwork(t *testing.T) {
 		receiverNodeAddr, err := receiverNode.RPCClient().GetNewAddress(ctx, t.Name())
 		is.NoErr(err)
 
-		const amount = 0.1
+		const amount = privatebtc.BTC / 10
 
 		ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 		defer cancel()
 
-		txHash, err := cr.SendTransactionOnNetwork(ctx, receiverNodeAddr, amount)
+		txHash, err := cr.SendTransactionOnNetwork(ctx, receiverNodeAddr, amount, privatebtc.SendOptions{})
 		is.NoErr(err)
 
 		receiverPendingBalanceAfterTx, err := receiverNode.RPCClient().GetBalance(ctx)
@@ -812,6 +1410,21 @@ func TestBitcoinPrivateNetwork(t *testing.T) {
 		err = cr.ReconnectNode(ctx)
 		is.NoErr(err)
 
+		tips, err := receiverNode.RPCClient().GetChainTips(ctx)
+		is.NoErr(err)
+
+		orphanedTipIndex := slices.IndexFunc(tips, func(tip privatebtc.ChainTip) bool {
+			return tip.Status == privatebtc.ChainTipStatusValidFork
+		})
+		is.True(orphanedTipIndex != -1)
+		is.Equal(tips[orphanedTipIndex].Hash, blockHashes[0])
+
+		orphanedBlocks, err := receiverNode.ForkBlocks(ctx, tips[orphanedTipIndex])
+		is.NoErr(err)
+		is.Equal(len(orphanedBlocks), 1)
+		is.Equal(orphanedBlocks[0].Confirmations, -1)
+		is.True(slices.Contains(orphanedBlocks[0].TxIDs(), txHash))
+
 		txAfterReorg, err := receiverNode.RPCClient().GetTransaction(ctx, txHash)
 		is.NoErr(err)
 		is.Equal(txAfterReorg.BlockHash, "")
@@ -829,4 +1442,27 @@ func TestBitcoinPrivateNetwork(t *testing.T) {
 		err = nodesWithoutReorg.EnsureTransactionInEveryMempool(syncCtx, txHash)
 		is.NoErr(err)
 	})
+
+	t.Run("AddAndRemoveNode", func(t *testing.T) {
+		is := is.New(t)
+
+		bestBlockHash, err := testNode.RPCClient().GetBestBlockHash(ctx)
+		is.NoErr(err)
+
+		addCtx, cancel := context.WithTimeout(ctx, 2*time.Minute)
+		defer cancel()
+
+		lateNode, err := pn.AddNode(addCtx)
+		is.NoErr(err)
+
+		lateNodeBestBlockHash, err := lateNode.RPCClient().GetBestBlockHash(ctx)
+		is.NoErr(err)
+
+		is.Equal(lateNodeBestBlockHash, bestBlockHash)
+
+		err = pn.RemoveNode(ctx, lateNode.ID())
+		is.NoErr(err)
+
+		is.Equal(len(pn.Nodes()), 4)
+	})
 }
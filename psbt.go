@@ -0,0 +1,171 @@
+package privatebtc
+
+import (
+	"context"
+	"fmt"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// PSBTRole is the role of the next participant in a PSBT workflow, as described
+// in BIP 174.
+type PSBTRole string
+
+// The PSBT roles.
+const (
+	PSBTRoleCreator   PSBTRole = "creator"
+	PSBTRoleUpdater   PSBTRole = "updater"
+	PSBTRoleSigner    PSBTRole = "signer"
+	PSBTRoleFinalizer PSBTRole = "finalizer"
+	PSBTRoleExtractor PSBTRole = "extractor"
+)
+
+// FundedPSBT is a PSBT funded by a node wallet.
+type FundedPSBT struct {
+	// PSBT is the base64 encoded PSBT.
+	PSBT string
+	Fee  Amount
+	// ChangePos is the position of the change output, -1 if there is no change output.
+	ChangePos int
+}
+
+// ProcessedPSBT is a PSBT updated, and possibly signed, by a node wallet.
+type ProcessedPSBT struct {
+	// PSBT is the base64 encoded PSBT.
+	PSBT string
+	// Complete reports whether the PSBT has all the signatures it needs.
+	Complete bool
+}
+
+// FinalizedPSBT is the result of finalizing a PSBT.
+type FinalizedPSBT struct {
+	// PSBT is the base64 encoded PSBT, set only when the PSBT is not complete.
+	PSBT string
+	// Hex is the hex encoded network transaction, set only when the PSBT is complete.
+	Hex string
+	// Complete reports whether the PSBT was completely finalized.
+	Complete bool
+}
+
+// PSBTAnalysis is the analysis of a PSBT and of its inputs.
+type PSBTAnalysis struct {
+	Inputs []PSBTInputAnalysis
+	// EstimatedVSize is the estimated virtual size of the final transaction,
+	// set only when every input has its UTXO.
+	EstimatedVSize int
+	// EstimatedFeeRate is the estimated fee rate of the final transaction,
+	// set only when every input has its UTXO.
+	EstimatedFeeRate FeeRate
+	// Fee is the transaction fee, set only when every input has its UTXO.
+	Fee Amount
+	// Next is the role of the next participant in the workflow.
+	Next PSBTRole
+	// Error is set when the PSBT is invalid.
+	Error string
+}
+
+// PSBTInputAnalysis is the analysis of a PSBT input.
+type PSBTInputAnalysis struct {
+	// HasUTXO reports whether the UTXO spent by the input is known.
+	HasUTXO bool
+	// IsFinal reports whether the input is finalized.
+	IsFinal bool
+	// MissingPubKeys are the hashes of the public keys whose BIP 32 derivation
+	// paths are missing.
+	MissingPubKeys []string
+	// MissingSignatures are the hashes of the public keys whose signatures are missing.
+	MissingSignatures []string
+	// MissingRedeemScript is the hash of the missing redeem script.
+	MissingRedeemScript string
+	// MissingWitnessScript is the hash of the missing witness script.
+	MissingWitnessScript string
+	// Next is the role of the next participant for the input.
+	Next PSBTRole
+}
+
+// SignPSBT passes the given base64 encoded PSBT to the wallet of every node for
+// signing and combines the signed PSBTs using the first node, returning the combined PSBT.
+func (nodes Nodes) SignPSBT(ctx context.Context, psbt string) (string, error) {
+	if len(nodes) == 0 {
+		return "", ErrNoPSBTSigners
+	}
+
+	signers := make([]psbtSigner, len(nodes))
+
+	for i := range nodes {
+		signers[i] = psbtSigner{nodeID: nodes[i].id, rpcClient: nodes[i].RPCClient()}
+	}
+
+	return signPSBT(ctx, signers, nodes[0].RPCClient(), psbt)
+}
+
+// SignAndBroadcastPSBT signs the given base64 encoded PSBT using SignPSBT, finalizes
+// it and broadcasts the resulting transaction through the first node.
+func (nodes Nodes) SignAndBroadcastPSBT(ctx context.Context, psbt string) (txHash string, _ error) {
+	combined, err := nodes.SignPSBT(ctx, psbt)
+	if err != nil {
+		return "", fmt.Errorf("sign psbt: %w", err)
+	}
+
+	return finalizeAndBroadcastPSBT(ctx, nodes[0].RPCClient(), combined)
+}
+
+// psbtSigner is a wallet signing a PSBT.
+type psbtSigner struct {
+	nodeID    int
+	rpcClient RPCClient
+}
+
+// signPSBT signs the given PSBT with every signer wallet and combines the signed
+// PSBTs using the combiner.
+func signPSBT(ctx context.Context, signers []psbtSigner, combiner RPCClient, psbt string) (string, error) {
+	signed := make([]string, len(signers))
+
+	eg, egCtx := errgroup.WithContext(ctx)
+
+	for i := range signers {
+		i := i
+
+		eg.Go(func() error {
+			res, err := signers[i].rpcClient.WalletProcessPSBT(egCtx, psbt, true)
+			if err != nil {
+				return fmt.Errorf("process psbt on node %d: %w", signers[i].nodeID, err)
+			}
+
+			signed[i] = res.PSBT
+
+			return nil
+		})
+	}
+
+	if err := eg.Wait(); err != nil {
+		return "", err
+	}
+
+	combined, err := combiner.CombinePSBT(ctx, signed)
+	if err != nil {
+		return "", fmt.Errorf("combine psbt: %w", err)
+	}
+
+	return combined, nil
+}
+
+// finalizeAndBroadcastPSBT finalizes the given signed PSBT and broadcasts the
+// resulting transaction using the given client.
+func finalizeAndBroadcastPSBT(ctx context.Context, rpcClient RPCClient, psbt string) (string, error) {
+	finalized, err := rpcClient.FinalizePSBT(ctx, psbt)
+	if err != nil {
+		return "", fmt.Errorf("finalize psbt: %w", err)
+	}
+
+	if !finalized.Complete {
+		return "", ErrPSBTNotComplete
+	}
+
+	txHash, err := rpcClient.SendRawTransaction(ctx, finalized.Hex)
+	if err != nil {
+		return "", fmt.Errorf("send raw transaction: %w", err)
+	}
+
+	return txHash, nil
+}
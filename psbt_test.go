@@ -0,0 +1,139 @@
+package privatebtc_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/adrianbrad/privatebtc"
+	"github.com/adrianbrad/privatebtc/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodesSignAndBroadcastPSBT(t *testing.T) {
+	t.Parallel()
+
+	errProcess := errors.New("process error")
+
+	tests := map[string]struct {
+		signers         []int
+		processErr      error
+		incomplete      bool
+		expectedCombine []string
+		expectedError   error
+	}{
+		"AllNodes": {
+			signers:         []int{0, 1, 2},
+			expectedCombine: []string{"psbt_signed_0", "psbt_signed_1", "psbt_signed_2"},
+		},
+		"SubsetOfNodes": {
+			signers:         []int{2, 1},
+			expectedCombine: []string{"psbt_signed_2", "psbt_signed_1"},
+		},
+		"NoSigners": {
+			signers:       []int{},
+			expectedError: privatebtc.ErrNoPSBTSigners,
+		},
+		"ProcessError": {
+			signers:       []int{0, 1},
+			processErr:    errProcess,
+			expectedError: errProcess,
+		},
+		"NotComplete": {
+			signers:       []int{0, 1},
+			incomplete:    true,
+			expectedError: privatebtc.ErrPSBTNotComplete,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := require.New(t)
+
+			var (
+				mu       sync.Mutex
+				combined []string
+				sent     []string
+			)
+
+			mocks := newMockNodes(func(id int) *mock.RPCClient {
+				return &mock.RPCClient{
+					WalletProcessPSBTFunc: func(
+						_ context.Context,
+						psbt string,
+						sign bool,
+					) (*privatebtc.ProcessedPSBT, error) {
+						if test.processErr != nil {
+							return nil, test.processErr
+						}
+
+						req.True(sign)
+
+						return &privatebtc.ProcessedPSBT{PSBT: psbt + "_signed_" + strconv.Itoa(id)}, nil
+					},
+					CombinePSBTFunc: func(_ context.Context, psbts []string) (string, error) {
+						mu.Lock()
+						defer mu.Unlock()
+
+						req.Equal(test.signers[0], id)
+
+						combined = psbts
+
+						return "combined", nil
+					},
+					FinalizePSBTFunc: func(_ context.Context, psbt string) (*privatebtc.FinalizedPSBT, error) {
+						req.Equal("combined", psbt)
+
+						if test.incomplete {
+							return &privatebtc.FinalizedPSBT{PSBT: psbt}, nil
+						}
+
+						return &privatebtc.FinalizedPSBT{Hex: "txhex", Complete: true}, nil
+					},
+					SendRawTransactionFunc: func(_ context.Context, txHex string) (string, error) {
+						mu.Lock()
+						defer mu.Unlock()
+
+						sent = append(sent, txHex)
+
+						return "txhash", nil
+					},
+				}
+			})
+
+			pn, err := privatebtc.NewPrivateNetwork(
+				mocks.nodeService(3),
+				mocks.rpcClientFactory(),
+				3,
+			)
+			req.NoError(err)
+
+			req.NoError(pn.Start(context.Background()))
+
+			signers := make(privatebtc.Nodes, len(test.signers))
+
+			for i, id := range test.signers {
+				signers[i] = pn.Nodes()[id]
+			}
+
+			txHash, err := signers.SignAndBroadcastPSBT(context.Background(), "psbt")
+			req.ErrorIs(err, test.expectedError)
+
+			if test.expectedError != nil {
+				req.Empty(sent)
+
+				return
+			}
+
+			req.Equal("txhash", txHash)
+			req.Equal(test.expectedCombine, combined)
+			req.Equal([]string{"txhex"}, sent)
+		})
+	}
+}
@@ -2,17 +2,32 @@ package privatebtc
 
 import (
 	"context"
+	"time"
 )
 
 // RPCClient is an interface for RPC clients.
 // The methods are closely mapped to the bitcoin RPC API.
+//
+// Cancelling the context of a call only stops waiting for its result, the call
+// returns the context error but the RPC is not cancelled. A request already sent
+// is still executed by the node, e.g. the blocks of a cancelled GenerateToAddress
+// may still be mined. A cancelled call does not delay the later calls of the client.
 // nolint: interfacebloat, revive
 type RPCClient interface {
-	// SendToAddress sends the given satoshi amount to the given address.
-	SendToAddress(ctx context.Context, address string, amount float64) (txHash string, _ error)
-
-	// SendCustomTransaction sends a custom transaction with the given inputs and amounts.
-	SendCustomTransaction(ctx context.Context, inputs []TransactionVin, amounts map[string]float64) (txHash string, _ error)
+	// SendToAddress sends the given amount to the given address, paying the fee
+	// as configured by the given options.
+	SendToAddress(ctx context.Context, address string, amount Amount, opts SendOptions) (txHash string, _ error)
+
+	// SendCustomTransaction sends a custom transaction with the given inputs and amounts,
+	// locked until the given nLockTime, the transaction is not locked when zero.
+	// No change output is added, the difference between the inputs and the amounts
+	// is paid as fee, use a CoinControlTransaction to get the change back.
+	SendCustomTransaction(
+		ctx context.Context,
+		inputs []TransactionVin,
+		amounts map[string]Amount,
+		lockTime uint32,
+	) (txHash string, _ error)
 
 	// GenerateToAddress generates the given number of blocks to the given address.
 	GenerateToAddress(ctx context.Context, numBlocks int64, address string) (blockHashes []string, _ error)
@@ -26,12 +41,56 @@ type RPCClient interface {
 	// RemovePeer removes the given peer from the node.
 	RemovePeer(ctx context.Context, peer Node) error
 
+	// GetPeerInfo returns the peers connected to the node.
+	GetPeerInfo(ctx context.Context) ([]Peer, error)
+
+	// BanPeer disconnects the given peer and bans its IP address for the given
+	// duration, or for the node default of 24 hours if zero.
+	BanPeer(ctx context.Context, peer Node, duration time.Duration) error
+
+	// UnbanPeer lifts the ban of the IP address of the given peer.
+	UnbanPeer(ctx context.Context, peer Node) error
+
+	// SetNetworkActive enables or disables all the P2P network activity of the node,
+	// the connections are dropped when disabled.
+	SetNetworkActive(ctx context.Context, active bool) error
+
 	// CreateWallet creates a new wallet with the given name.
 	CreateWallet(ctx context.Context, walletName string) error
 
+	// CreateWatchOnlyWallet creates a new blank descriptor wallet, with private keys
+	// disabled, with the given name. The wallet descriptors are imported using
+	// ImportDescriptors.
+	CreateWatchOnlyWallet(ctx context.Context, walletName string) error
+
+	// Wallet returns a client whose wallet RPCs are served by the wallet with the
+	// given name, which is required once a node has more than one wallet loaded.
+	Wallet(walletName string) (RPCClient, error)
+
+	// LoadWallet loads the existing wallet with the given name.
+	LoadWallet(ctx context.Context, walletName string) error
+
+	// UnloadWallet unloads the wallet with the given name, it can be loaded back
+	// with LoadWallet.
+	UnloadWallet(ctx context.Context, walletName string) error
+
+	// ListWallets returns the names of the loaded wallets.
+	ListWallets(ctx context.Context) ([]string, error)
+
+	// FlushChainState flushes the node chain state to disk.
+	FlushChainState(ctx context.Context) error
+
+	// SetMockTime sets the node local time to the given time, the zero time resets
+	// the node to the system time. Regtest only.
+	SetMockTime(ctx context.Context, t time.Time) error
+
 	// GetRawMempool returns all transaction ids in memory pool
 	GetRawMempool(ctx context.Context) ([]string, error)
 
+	// GetMempoolEntry returns the mempool entry of the transaction with the given hash,
+	// ErrTxNotFoundInMempool is returned if the transaction is not in the mempool.
+	GetMempoolEntry(ctx context.Context, txHash string) (*MempoolEntry, error)
+
 	// GetBlockCount returns the number of blocks in the longest blockchain.
 	GetBlockCount(ctx context.Context) (int, error)
 
@@ -51,9 +110,128 @@ type RPCClient interface {
 
 	GetBestBlockHash(ctx context.Context) (string, error)
 
-	GetCoinbaseValue(ctx context.Context) (int64, error)
+	GetCoinbaseValue(ctx context.Context) (Amount, error)
 
+	// GetTransactionOutputs returns the outputs of the mempool transaction with the given hash,
+	// ErrTxNotFoundInMempool is returned if the transaction is not in the mempool.
 	GetTransactionOutputs(ctx context.Context, txHash string) ([]MempoolTransactionOutput, error)
+
+	// GetBlock returns the block with the given hash along with its transactions.
+	GetBlock(ctx context.Context, blockHash string) (*Block, error)
+
+	// GetBlockHeader returns the header of the block with the given hash.
+	GetBlockHeader(ctx context.Context, blockHash string) (*BlockHeader, error)
+
+	// GetBlockHash returns the hash of the block at the given height in the active chain.
+	GetBlockHash(ctx context.Context, height int) (string, error)
+
+	// GetChainTips returns the tips of all the chains known by the node, including
+	// the active chain and the branches orphaned by chain reorgs.
+	GetChainTips(ctx context.Context) ([]ChainTip, error)
+
+	// SendRawTransaction broadcasts the given hex encoded signed transaction,
+	// the transaction fee rate is not capped.
+	SendRawTransaction(ctx context.Context, txHex string) (txHash string, _ error)
+
+	// TestMempoolAccept tests whether the given hex encoded signed transactions would be
+	// accepted into the mempool, without broadcasting them. A single transaction is tested
+	// on its own, several transactions are tested as a package, in which the parents come
+	// before their children. The results are in the order of the given transactions and
+	// the transactions fee rate is not capped.
+	TestMempoolAccept(ctx context.Context, txHexes []string) ([]MempoolAcceptResult, error)
+
+	// SubmitPackage broadcasts the given hex encoded signed transactions as a package,
+	// a child with all of its unconfirmed parents, in which the parents come before the child.
+	// Requires Bitcoin Core v26 or newer.
+	SubmitPackage(ctx context.Context, txHexes []string) (*PackageSubmitResult, error)
+
+	// WalletCreateFundedPSBT creates a PSBT paying the given amounts, funded by the
+	// wallet, paying the fee as configured by the given options. The wallet selects
+	// the inputs when none are given, otherwise only the given inputs are spent.
+	WalletCreateFundedPSBT(
+		ctx context.Context,
+		inputs []TransactionVin,
+		amounts map[string]Amount,
+		opts SendOptions,
+	) (*FundedPSBT, error)
+
+	// WalletProcessPSBT updates the given PSBT with the wallet data and signs its
+	// inputs if sign is true.
+	WalletProcessPSBT(ctx context.Context, psbt string, sign bool) (*ProcessedPSBT, error)
+
+	// CombinePSBT combines the given PSBTs of the same transaction into a single PSBT.
+	CombinePSBT(ctx context.Context, psbts []string) (string, error)
+
+	// FinalizePSBT finalizes the given PSBT, extracting the network transaction
+	// if the PSBT is complete.
+	FinalizePSBT(ctx context.Context, psbt string) (*FinalizedPSBT, error)
+
+	// AnalyzePSBT analyzes the given PSBT and returns the next role of the workflow.
+	AnalyzePSBT(ctx context.Context, psbt string) (*PSBTAnalysis, error)
+
+	// BumpFee replaces the given wallet transaction with a transaction paying the
+	// given fee rate, keeping its outputs and reducing its change.
+	BumpFee(ctx context.Context, txHash string, feeRate FeeRate) (*BumpedTransaction, error)
+
+	// PSBTBumpFee creates an unsigned PSBT replacing the given wallet transaction with
+	// a transaction paying the given fee rate, for wallets which cannot sign.
+	PSBTBumpFee(ctx context.Context, txHash string, feeRate FeeRate) (*BumpedTransaction, error)
+
+	// ListUnspent returns the wallet UTXOs matching the given filter.
+	ListUnspent(ctx context.Context, filter UTXOFilter) ([]UTXO, error)
+
+	// LockUnspent locks the given UTXOs, so that the wallet does not select them
+	// when funding transactions.
+	LockUnspent(ctx context.Context, outpoints []TransactionVin) error
+
+	// UnlockUnspent unlocks the given UTXOs, or every locked UTXO if none are given.
+	UnlockUnspent(ctx context.Context, outpoints []TransactionVin) error
+
+	// ListLockUnspent returns the locked UTXOs.
+	ListLockUnspent(ctx context.Context) ([]TransactionVin, error)
+
+	// ListDescriptors returns the descriptors of the wallet, including their private
+	// keys if private is true.
+	ListDescriptors(ctx context.Context, private bool) ([]Descriptor, error)
+
+	// ImportDescriptors imports the given descriptors into the wallet.
+	ImportDescriptors(ctx context.Context, requests []ImportDescriptorRequest) error
+
+	// GetDescriptorInfo analyzes the given descriptor.
+	GetDescriptorInfo(ctx context.Context, descriptor string) (*DescriptorInfo, error)
+
+	// DeriveAddress returns the address of the given descriptor, which must not
+	// be ranged.
+	DeriveAddress(ctx context.Context, descriptor string) (string, error)
+
+	// GetAddressInfo returns the information the wallet has about the given address.
+	GetAddressInfo(ctx context.Context, address string) (*AddressInfo, error)
+
+	// CreateMultisig creates a P2WSH multisig address requiring nRequired signatures
+	// of the given hex encoded public keys.
+	CreateMultisig(ctx context.Context, nRequired int, pubKeys []string) (*Multisig, error)
+}
+
+// BatchTransactionOutputsGetter is implemented by the RPC clients which are able to
+// fetch the outputs of several transactions in a single round trip.
+// Nodes.NetworkMempool uses it, when available, instead of calling
+// RPCClient.GetTransactionOutputs for every transaction.
+type BatchTransactionOutputsGetter interface {
+	// GetTransactionsOutputs returns the outputs of the given transactions,
+	// in the order of the given hashes. The outputs of the transactions which are
+	// not in the mempool, e.g. mined or replaced since listed, are nil.
+	GetTransactionsOutputs(ctx context.Context, txHashes []string) ([][]MempoolTransactionOutput, error)
+}
+
+// BatchMempoolEntriesGetter is implemented by the RPC clients which are able to
+// fetch the mempool entries of several transactions in a single round trip.
+// Nodes.NetworkMempool uses it, when available, instead of calling
+// RPCClient.GetMempoolEntry for every transaction.
+type BatchMempoolEntriesGetter interface {
+	// GetMempoolEntries returns the mempool entries of the given transactions,
+	// in the order of the given hashes. The entries of the transactions which are
+	// not in the mempool, e.g. mined or replaced since listed, are nil.
+	GetMempoolEntries(ctx context.Context, txHashes []string) ([]*MempoolEntry, error)
 }
 
 // RPCClientFactory is an interface for RPC client factories.
@@ -61,5 +239,7 @@ type RPCClient interface {
 // We have to use the factory pattern because the RPC Clients are created dynamically for each
 // node when the network is created.
 type RPCClientFactory interface {
-	NewRPCClient(hostRPCPort, rpcUser, rpcPass string) (RPCClient, error)
+	// NewRPCClient creates an RPC client for the node listening on the given host RPC port.
+	// The given context bounds the connection check the factory may run against the node.
+	NewRPCClient(ctx context.Context, hostRPCPort, rpcUser, rpcPass string) (RPCClient, error)
 }
@@ -0,0 +1,88 @@
+package privatebtc
+
+import "fmt"
+
+// SendOptions configures how a node wallet funds and pays for a transaction.
+// The zero value leaves every setting to the node, which pays the fee estimated
+// for the default confirmation target or, on a private network with no fee
+// estimates, the -fallbackfee.
+type SendOptions struct {
+	// FeeRate is the fee rate paid by the transaction, it cannot be combined with
+	// ConfTarget, see Validate.
+	FeeRate FeeRate
+	// ConfTarget is the number of blocks the transaction should confirm within,
+	// used to estimate the fee rate.
+	ConfTarget int
+	// SubtractFeeFromAmount deducts the fee from every amount sent, split equally
+	// between all the receivers, so that they get less than the amounts.
+	// The change output is never reduced. The rule is the same for every call,
+	// SendToAddress has a single receiver which pays the whole fee.
+	SubtractFeeFromAmount bool
+	// Replaceable signals BIP 125 replaceability, the node -walletrbf setting is
+	// used when false, except for the inputs given to WalletCreateFundedPSBT
+	// without a sequence, see InputSequence.
+	Replaceable bool
+	// ChangeAddress is the address receiving the change, a new wallet address is
+	// used when empty.
+	ChangeAddress string
+	// LockTime is the nLockTime of the transaction, a block height or, from
+	// LockTimeThreshold, a unix timestamp. The transaction is not locked when zero.
+	LockTime uint32
+}
+
+// nSequence values of the inputs given without one.
+const (
+	sequenceFinal       = 0xffffffff
+	sequenceNonFinal    = 0xfffffffe
+	sequenceReplaceable = 0xfffffffd
+)
+
+// InputSequence returns the nSequence of the inputs given without one: signalling
+// replaceability if Replaceable is set, otherwise final, unless LockTime is set,
+// which requires a non-final input to be enforced.
+func (o SendOptions) InputSequence() uint32 {
+	switch {
+	case o.Replaceable:
+		return sequenceReplaceable
+	case o.LockTime != 0:
+		return sequenceNonFinal
+	default:
+		return sequenceFinal
+	}
+}
+
+// Validate returns ErrConflictingFeeOptions if both FeeRate and ConfTarget are set.
+func (o SendOptions) Validate() error {
+	if o.FeeRate != 0 && o.ConfTarget != 0 {
+		return fmt.Errorf(
+			"fee rate %s and conf target %d: %w",
+			o.FeeRate,
+			o.ConfTarget,
+			ErrConflictingFeeOptions,
+		)
+	}
+
+	return nil
+}
+
+// SubtractFeeFromOutputs returns the indices of the outputs the fee is subtracted
+// from, given the number of receivers, which come first in the transaction outputs:
+// every receiver if SubtractFeeFromAmount is set, none otherwise.
+func (o SendOptions) SubtractFeeFromOutputs(receivers int) []int {
+	if !o.SubtractFeeFromAmount {
+		return nil
+	}
+
+	outputs := make([]int, receivers)
+
+	for i := range outputs {
+		outputs[i] = i
+	}
+
+	return outputs
+}
+
+// IsZero reports whether every option is left to the node.
+func (o SendOptions) IsZero() bool {
+	return o == SendOptions{}
+}
@@ -0,0 +1,60 @@
+package privatebtc_test
+
+import (
+	"testing"
+
+	"github.com/adrianbrad/privatebtc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendOptionsInputSequence(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		opts             privatebtc.SendOptions
+		expectedSequence uint32
+	}{
+		"Final": {
+			opts:             privatebtc.SendOptions{},
+			expectedSequence: 0xffffffff,
+		},
+		"LockTime": {
+			opts:             privatebtc.SendOptions{LockTime: 150},
+			expectedSequence: 0xfffffffe,
+		},
+		"Replaceable": {
+			opts:             privatebtc.SendOptions{Replaceable: true, LockTime: 150},
+			expectedSequence: 0xfffffffd,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, test.expectedSequence, test.opts.InputSequence())
+		})
+	}
+}
+
+func TestSendOptionsValidate(t *testing.T) {
+	t.Parallel()
+
+	req := require.New(t)
+
+	req.NoError(privatebtc.SendOptions{FeeRate: 2}.Validate())
+	req.NoError(privatebtc.SendOptions{ConfTarget: 6}.Validate())
+	req.ErrorIs(privatebtc.SendOptions{FeeRate: 2, ConfTarget: 6}.Validate(), privatebtc.ErrConflictingFeeOptions)
+}
+
+func TestSendOptionsSubtractFeeFromOutputs(t *testing.T) {
+	t.Parallel()
+
+	req := require.New(t)
+
+	req.Nil(privatebtc.SendOptions{}.SubtractFeeFromOutputs(3))
+	req.Equal([]int{0}, privatebtc.SendOptions{SubtractFeeFromAmount: true}.SubtractFeeFromOutputs(1))
+	req.Equal([]int{0, 1, 2}, privatebtc.SendOptions{SubtractFeeFromAmount: true}.SubtractFeeFromOutputs(3))
+}
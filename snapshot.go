@@ -0,0 +1,241 @@
+package privatebtc
+
+import (
+	"archive/tar"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// Network Snapshot
+// A snapshot holds a tar archive of the data directory of every node, along with a
+// manifest describing the network it was taken from.
+// Snapshots are stored in the snapshots directory, configured using WithSnapshotsDir,
+// every snapshot in its own subdirectory named after the snapshot.
+//
+// The mempool and the peer related files are not part of the snapshot, the nodes of a
+// network started from a snapshot are connected as described by its own topology.
+//
+// The nodes keep running while their data directories are copied:
+//   - the wallets are unloaded during the copy, so their files are closed and every
+//     wallet is captured in a consistent state. They are loaded back afterwards.
+//   - the chain state is flushed to disk before the copy, the block and chain state
+//     files are only consistent if no blocks are received while they are copied.
+
+const snapshotManifestFile = "manifest.json"
+
+// snapshotExcludedFiles are the data directory files which are not part of a snapshot.
+var snapshotExcludedFiles = map[string]struct{}{
+	"peers.dat":    {},
+	"anchors.dat":  {},
+	"banlist.dat":  {},
+	"banlist.json": {},
+	"debug.log":    {},
+	"mempool.dat":  {},
+	".lock":        {},
+	".cookie":      {},
+	"bitcoind.pid": {},
+}
+
+type snapshotManifest struct {
+	Nodes int `json:"nodes"`
+	// Wallets holds the name of the wallet of every node, indexed by node index.
+	Wallets map[int]string `json:"wallets"`
+}
+
+func snapshotNodeArchive(dir string, nodeIndex int) string {
+	return filepath.Join(dir, fmt.Sprintf("node_%d.tar", nodeIndex))
+}
+
+// Snapshot captures the chain state and the wallets of every node in the network,
+// the network can then be recreated using the WithSnapshot option.
+// An existing snapshot with the same name is overwritten.
+// The wallets of the nodes are unloaded while the snapshot is taken, and wallet RPCs
+// fail until they are loaded back.
+// The snapshot must be taken while the network is idle: blocks received during the
+// snapshot might leave the captured chain state inconsistent.
+func (n *PrivateNetwork) Snapshot(ctx context.Context, name string) error {
+	nodes := n.Nodes()
+
+	dir := filepath.Join(n.snapshotsDir, name)
+
+	n.logger.Info("📸⌛ Taking network snapshot", "name", name, "dir", dir)
+
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return fmt.Errorf("create snapshot dir: %w", err)
+	}
+
+	manifest := snapshotManifest{
+		Nodes:   len(nodes),
+		Wallets: map[int]string{},
+	}
+
+	n.mu.RLock()
+
+	for i := range nodes {
+		if walletName := n.nodeWallets[nodes[i].id]; walletName != nil {
+			manifest.Wallets[i] = *walletName
+		}
+	}
+
+	n.mu.RUnlock()
+
+	eg, egCtx := errgroup.WithContext(ctx)
+
+	for i := range nodes {
+		i := i
+
+		eg.Go(func() error {
+			if err := snapshotNode(egCtx, nodes[i], snapshotNodeArchive(dir, i)); err != nil {
+				return fmt.Errorf("snapshot node %d: %w", nodes[i].id, err)
+			}
+
+			return nil
+		})
+	}
+
+	if err := eg.Wait(); err != nil {
+		return err
+	}
+
+	manifestJSON, err := json.Marshal(manifest)
+	if err != nil {
+		return fmt.Errorf("marshal manifest: %w", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, snapshotManifestFile), manifestJSON, 0o600); err != nil {
+		return fmt.Errorf("write manifest: %w", err)
+	}
+
+	n.logger.Info("📸✅ Successfully took network snapshot", "name", name, "dir", dir)
+
+	return nil
+}
+
+func snapshotNode(ctx context.Context, node Node, archivePath string) (err error) {
+	client := node.RPCClient()
+
+	if err := client.FlushChainState(ctx); err != nil {
+		return fmt.Errorf("flush chain state: %w", err)
+	}
+
+	wallets, err := client.ListWallets(ctx)
+	if err != nil {
+		return fmt.Errorf("list wallets: %w", err)
+	}
+
+	var unloaded []string
+
+	// the wallets are loaded back even if the snapshot failed or the context is done.
+	defer func() {
+		loadCtx := context.WithoutCancel(ctx)
+
+		for _, walletName := range unloaded {
+			if loadErr := client.LoadWallet(loadCtx, walletName); loadErr != nil {
+				err = errors.Join(err, fmt.Errorf("load wallet %q: %w", walletName, loadErr))
+			}
+		}
+	}()
+
+	for _, walletName := range wallets {
+		if err := client.UnloadWallet(ctx, walletName); err != nil {
+			return fmt.Errorf("unload wallet %q: %w", walletName, err)
+		}
+
+		unloaded = append(unloaded, walletName)
+	}
+
+	datadir, err := node.NodeHandler().ExportDatadir(ctx)
+	if err != nil {
+		return fmt.Errorf("export datadir: %w", err)
+	}
+
+	defer func() {
+		if closeErr := datadir.Close(); closeErr != nil {
+			err = errors.Join(err, fmt.Errorf("close datadir: %w", closeErr))
+		}
+	}()
+
+	archive, err := os.Create(archivePath)
+	if err != nil {
+		return fmt.Errorf("create archive: %w", err)
+	}
+
+	defer func() {
+		if closeErr := archive.Close(); closeErr != nil {
+			err = errors.Join(err, fmt.Errorf("close archive: %w", closeErr))
+		}
+	}()
+
+	if err := copySnapshotArchive(archive, datadir); err != nil {
+		return fmt.Errorf("copy archive: %w", err)
+	}
+
+	return nil
+}
+
+// copySnapshotArchive copies the data directory tar archive, skipping the files
+// which are not part of a snapshot.
+func copySnapshotArchive(dst io.Writer, src io.Reader) error {
+	tr := tar.NewReader(src)
+	tw := tar.NewWriter(dst)
+
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return fmt.Errorf("read header: %w", err)
+		}
+
+		if _, excluded := snapshotExcludedFiles[path.Base(header.Name)]; excluded {
+			continue
+		}
+
+		if err := tw.WriteHeader(header); err != nil {
+			return fmt.Errorf("write header %q: %w", header.Name, err)
+		}
+
+		// nolint: gosec // the archive is produced by the node handler.
+		if _, err := io.Copy(tw, tr); err != nil {
+			return fmt.Errorf("copy %q: %w", header.Name, err)
+		}
+	}
+
+	return tw.Close()
+}
+
+// loadSnapshotManifest reads the manifest of the network snapshot and checks that
+// it was taken from a network with the given number of nodes.
+func loadSnapshotManifest(dir string, nodes int) (*snapshotManifest, error) {
+	manifestJSON, err := os.ReadFile(filepath.Join(dir, snapshotManifestFile))
+	if err != nil {
+		return nil, fmt.Errorf("read manifest: %w", err)
+	}
+
+	var manifest snapshotManifest
+
+	if err := json.Unmarshal(manifestJSON, &manifest); err != nil {
+		return nil, fmt.Errorf("unmarshal manifest: %w", err)
+	}
+
+	if manifest.Nodes != nodes {
+		return nil, fmt.Errorf(
+			"snapshot nodes %d, network nodes %d: %w",
+			manifest.Nodes,
+			nodes,
+			ErrSnapshotNodeCountMismatch,
+		)
+	}
+
+	return &manifest, nil
+}
@@ -0,0 +1,254 @@
+package privatebtc_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/adrianbrad/privatebtc"
+	"github.com/adrianbrad/privatebtc/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newDatadirArchive(t *testing.T, files ...string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+
+	tw := tar.NewWriter(&buf)
+
+	for _, name := range files {
+		err := tw.WriteHeader(&tar.Header{
+			Name: name,
+			Mode: 0o600,
+			Size: int64(len(name)),
+		})
+		require.NoError(t, err)
+
+		_, err = tw.Write([]byte(name))
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, tw.Close())
+
+	return buf.Bytes()
+}
+
+func readArchiveFiles(t *testing.T, archivePath string) []string {
+	t.Helper()
+
+	archive, err := os.Open(archivePath)
+	require.NoError(t, err)
+
+	defer archive.Close()
+
+	var files []string
+
+	tr := tar.NewReader(archive)
+
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return files
+		}
+
+		require.NoError(t, err)
+
+		files = append(files, header.Name)
+	}
+}
+
+type snapshotWallets struct {
+	mu       sync.Mutex
+	created  []string
+	loaded   []string
+	unloaded []string
+}
+
+func (w *snapshotWallets) rpcClient(int) *mock.RPCClient {
+	return &mock.RPCClient{
+		FlushChainStateFunc: func(context.Context) error {
+			return nil
+		},
+		CreateWalletFunc: func(_ context.Context, walletName string) error {
+			w.mu.Lock()
+			defer w.mu.Unlock()
+
+			w.created = append(w.created, walletName)
+
+			return nil
+		},
+		LoadWalletFunc: func(_ context.Context, walletName string) error {
+			w.mu.Lock()
+			defer w.mu.Unlock()
+
+			w.loaded = append(w.loaded, walletName)
+
+			return nil
+		},
+		ListWalletsFunc: func(context.Context) ([]string, error) {
+			return []string{"wallet"}, nil
+		},
+		UnloadWalletFunc: func(_ context.Context, walletName string) error {
+			w.mu.Lock()
+			defer w.mu.Unlock()
+
+			w.unloaded = append(w.unloaded, walletName)
+
+			return nil
+		},
+	}
+}
+
+// snapshotNodeService records the snapshot path of every node request, the created
+// nodes export the given data directory archive.
+func snapshotNodeService(
+	mocks *mockNodes,
+	datadir []byte,
+	snapshotPaths *[]string,
+) *mock.NodeService {
+	nodeService := mocks.namedNodeService()
+	createNodes := nodeService.CreateNodesFunc
+
+	nodeService.CreateNodesFunc = func(
+		ctx context.Context,
+		nodeRequests []privatebtc.CreateNodeRequest,
+	) ([]privatebtc.NodeHandler, error) {
+		handlers, err := createNodes(ctx, nodeRequests)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range handlers {
+			*snapshotPaths = append(*snapshotPaths, nodeRequests[i].SnapshotPath)
+
+			handlers[i].(*mock.NodeHandler).ExportDatadirFunc = func(context.Context) (io.ReadCloser, error) {
+				return io.NopCloser(bytes.NewReader(datadir)), nil
+			}
+		}
+
+		return handlers, nil
+	}
+
+	return nodeService
+}
+
+func TestSnapshot(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	req := require.New(t)
+
+	snapshotsDir := t.TempDir()
+
+	datadir := newDatadirArchive(
+		t,
+		".bitcoin/regtest/blocks/blk00000.dat",
+		".bitcoin/regtest/chainstate/000003.ldb",
+		".bitcoin/regtest/wallets/wallet/wallet.dat",
+		".bitcoin/regtest/peers.dat",
+		".bitcoin/regtest/debug.log",
+		".bitcoin/regtest/.lock",
+	)
+
+	wallets := &snapshotWallets{}
+
+	var snapshotPaths []string
+
+	newNetwork := func(nodes int, opts ...privatebtc.Option) (*privatebtc.PrivateNetwork, error) {
+		mocks := newMockNodes(wallets.rpcClient)
+
+		return privatebtc.NewPrivateNetwork(
+			snapshotNodeService(mocks, datadir, &snapshotPaths),
+			mocks.rpcClientFactory(),
+			nodes,
+			append(opts, privatebtc.WithSnapshotsDir(snapshotsDir))...,
+		)
+	}
+
+	const nodes = 2
+
+	pn, err := newNetwork(nodes, privatebtc.WithWallet("wallet"))
+	req.NoError(err)
+
+	err = pn.Start(ctx)
+	req.NoError(err)
+
+	req.Equal([]string{"", ""}, snapshotPaths)
+	req.Equal([]string{"wallet", "wallet"}, wallets.created)
+
+	err = pn.Snapshot(ctx, "fixture")
+	req.NoError(err)
+
+	// the wallets are unloaded during the copy and loaded back afterwards.
+	req.Equal([]string{"wallet", "wallet"}, wallets.unloaded)
+	req.Equal([]string{"wallet", "wallet"}, wallets.loaded)
+
+	for i := 0; i < nodes; i++ {
+		req.Equal(
+			[]string{
+				".bitcoin/regtest/blocks/blk00000.dat",
+				".bitcoin/regtest/chainstate/000003.ldb",
+				".bitcoin/regtest/wallets/wallet/wallet.dat",
+			},
+			readArchiveFiles(t, filepath.Join(snapshotsDir, "fixture", fmt.Sprintf("node_%d.tar", i))),
+		)
+	}
+
+	manifest, err := os.ReadFile(filepath.Join(snapshotsDir, "fixture", "manifest.json"))
+	req.NoError(err)
+
+	var manifestJSON map[string]any
+
+	req.NoError(json.Unmarshal(manifest, &manifestJSON))
+	req.Equal(float64(nodes), manifestJSON["nodes"])
+
+	t.Run("Restore", func(t *testing.T) {
+		req := require.New(t)
+
+		snapshotPaths = nil
+		wallets.created = nil
+		wallets.loaded = nil
+
+		restored, err := newNetwork(nodes, privatebtc.WithSnapshot("fixture"))
+		req.NoError(err)
+
+		err = restored.Start(ctx)
+		req.NoError(err)
+
+		req.Equal(
+			[]string{
+				filepath.Join(snapshotsDir, "fixture", "node_0.tar"),
+				filepath.Join(snapshotsDir, "fixture", "node_1.tar"),
+			},
+			snapshotPaths,
+		)
+
+		req.Empty(wallets.created)
+		req.Equal([]string{"wallet", "wallet"}, wallets.loaded)
+	})
+
+	t.Run("NodeCountMismatch", func(t *testing.T) {
+		pn, err := newNetwork(nodes+1, privatebtc.WithSnapshot("fixture"))
+		require.NoError(t, err)
+
+		err = pn.Start(ctx)
+		require.ErrorIs(t, err, privatebtc.ErrSnapshotNodeCountMismatch)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		pn, err := newNetwork(nodes, privatebtc.WithSnapshot("missing"))
+		require.NoError(t, err)
+
+		err = pn.Start(ctx)
+		require.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
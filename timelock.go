@@ -0,0 +1,213 @@
+package privatebtc
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LockTimeThreshold is the lock time from which an absolute lock time is a unix
+// timestamp instead of a block height.
+const LockTimeThreshold = 500_000_000
+
+// BIP 68 relative lock time encoding.
+const (
+	sequenceLockTimeTypeFlag    = 1 << 22
+	sequenceLockTimeGranularity = 512 * time.Second
+	sequenceLockTimeMask        = 0xffff
+)
+
+// maxTimelock is the maximum timelock accepted by the after and older descriptor
+// fragments, greater values disable the timelock.
+const maxTimelock = 1<<31 - 1
+
+// RelativeLockBlocks returns the BIP 68 nSequence locking an output for the given
+// number of blocks after its confirmation.
+func RelativeLockBlocks(blocks uint16) uint32 {
+	return uint32(blocks)
+}
+
+// RelativeLockTime returns the BIP 68 nSequence locking an output for the given
+// duration after its confirmation, measured against the median time past.
+// The duration is rounded up to a multiple of 512 seconds and capped to 65535 of them.
+func RelativeLockTime(d time.Duration) uint32 {
+	units := (d + sequenceLockTimeGranularity - 1) / sequenceLockTimeGranularity
+	if units > sequenceLockTimeMask {
+		units = sequenceLockTimeMask
+	}
+
+	return sequenceLockTimeTypeFlag | uint32(units)
+}
+
+// TimelockedAddress is a P2WSH address of a node wallet whose outputs are locked
+// with OP_CHECKLOCKTIMEVERIFY until an absolute lock time, or with
+// OP_CHECKSEQUENCEVERIFY until a relative lock time elapses after their confirmation.
+// The address descriptor is imported into the wallet, which signs the spends.
+// Requires Bitcoin Core v25 or newer, which signs for miniscript descriptors.
+type TimelockedAddress struct {
+	client     RPCClient
+	address    string
+	descriptor string
+	lockTime   uint32
+	sequence   uint32
+}
+
+// NewCLTVAddress creates an address of the wallet of the given client whose outputs
+// can be spent from the given lock time, a block height or, from LockTimeThreshold,
+// a unix timestamp compared against the median time past.
+func NewCLTVAddress(ctx context.Context, client RPCClient, lockTime uint32) (*TimelockedAddress, error) {
+	if lockTime == 0 || lockTime > maxTimelock {
+		return nil, fmt.Errorf("lock time %d: %w", lockTime, ErrInvalidTimelock)
+	}
+
+	return newTimelockedAddress(ctx, client, "after("+strconv.FormatUint(uint64(lockTime), 10)+")", lockTime, 0)
+}
+
+// NewCSVAddress creates an address of the wallet of the given client whose outputs
+// can be spent once the given relative lock time, a BIP 68 nSequence built with
+// RelativeLockBlocks or RelativeLockTime, elapses after their confirmation.
+func NewCSVAddress(ctx context.Context, client RPCClient, sequence uint32) (*TimelockedAddress, error) {
+	if sequence == 0 || sequence > maxTimelock {
+		return nil, fmt.Errorf("sequence %d: %w", sequence, ErrInvalidTimelock)
+	}
+
+	return newTimelockedAddress(ctx, client, "older("+strconv.FormatUint(uint64(sequence), 10)+")", 0, sequence)
+}
+
+func newTimelockedAddress(
+	ctx context.Context,
+	client RPCClient,
+	timelock string,
+	lockTime uint32,
+	sequence uint32,
+) (*TimelockedAddress, error) {
+	key, err := timelockKey(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	desc := "wsh(and_v(v:pk(" + key + ")," + timelock + "))"
+
+	info, err := client.GetDescriptorInfo(ctx, desc)
+	if err != nil {
+		return nil, fmt.Errorf("get descriptor info: %w", err)
+	}
+
+	if err := client.ImportDescriptors(ctx, []ImportDescriptorRequest{
+		{Desc: desc + "#" + info.Checksum, Label: "timelock"},
+	}); err != nil {
+		return nil, fmt.Errorf("import descriptors: %w", err)
+	}
+
+	// the public descriptor is derived, so that the private key is not sent again.
+	address, err := client.DeriveAddress(ctx, info.Descriptor)
+	if err != nil {
+		return nil, fmt.Errorf("derive address: %w", err)
+	}
+
+	return &TimelockedAddress{
+		client:     client,
+		address:    address,
+		descriptor: info.Descriptor,
+		lockTime:   lockTime,
+		sequence:   sequence,
+	}, nil
+}
+
+// timelockKey reserves a new receive address of the given wallet and returns its
+// private key along with its derivation path, e.g. tprv.../84h/1h/0h/0/5, so that
+// every timelocked address uses its own key.
+func timelockKey(ctx context.Context, client RPCClient) (string, error) {
+	address, err := client.GetNewAddress(ctx, "timelock")
+	if err != nil {
+		return "", fmt.Errorf("get new address: %w", err)
+	}
+
+	info, err := client.GetAddressInfo(ctx, address)
+	if err != nil {
+		return "", fmt.Errorf("get address info: %w", err)
+	}
+
+	// the wallet receive descriptor derives the key of the new address, unless the
+	// node is configured with another address type.
+	if !strings.HasPrefix(info.Descriptor, "wpkh(") || info.HDKeyPath == "" {
+		return "", fmt.Errorf("address %q: %w", address, ErrTimelockKeyNotFound)
+	}
+
+	index := info.HDKeyPath[strings.LastIndex(info.HDKeyPath, "/")+1:]
+
+	descriptors, err := client.ListDescriptors(ctx, true)
+	if err != nil {
+		return "", fmt.Errorf("list descriptors: %w", err)
+	}
+
+	for _, d := range descriptors {
+		if !d.Active || d.Internal {
+			continue
+		}
+
+		desc, _, _ := strings.Cut(d.Desc, "#")
+
+		key, ok := strings.CutPrefix(desc, "wpkh(")
+		if !ok {
+			continue
+		}
+
+		key, ok = strings.CutSuffix(key, "/*)")
+		if !ok {
+			continue
+		}
+
+		return key + "/" + index, nil
+	}
+
+	return "", ErrTimelockKeyNotFound
+}
+
+// Address returns the timelocked address.
+func (a *TimelockedAddress) Address() string {
+	return a.address
+}
+
+// Descriptor returns the public descriptor of the address.
+func (a *TimelockedAddress) Descriptor() string {
+	return a.descriptor
+}
+
+// LockTime returns the absolute lock time of the address, zero for a relative timelock.
+func (a *TimelockedAddress) LockTime() uint32 {
+	return a.lockTime
+}
+
+// Sequence returns the relative lock time of the address, zero for an absolute timelock.
+func (a *TimelockedAddress) Sequence() uint32 {
+	return a.sequence
+}
+
+// Spend sends the given output of the address to the given address, the fee is
+// subtracted from the output amount. The node rejects the transaction until the
+// timelock expires, as non-final for an absolute timelock and as non-BIP68-final
+// for a relative one.
+func (a *TimelockedAddress) Spend(ctx context.Context, utxo UTXO, address string) (txHash string, _ error) {
+	input := utxo.Outpoint()
+	input.Sequence = a.sequence
+
+	funded, err := a.client.WalletCreateFundedPSBT(
+		ctx,
+		[]TransactionVin{input},
+		map[string]Amount{address: utxo.Amount},
+		SendOptions{SubtractFeeFromAmount: true, LockTime: a.lockTime},
+	)
+	if err != nil {
+		return "", fmt.Errorf("wallet create funded psbt: %w", err)
+	}
+
+	signed, err := a.client.WalletProcessPSBT(ctx, funded.PSBT, true)
+	if err != nil {
+		return "", fmt.Errorf("wallet process psbt: %w", err)
+	}
+
+	return finalizeAndBroadcastPSBT(ctx, a.client, signed.PSBT)
+}
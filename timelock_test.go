@@ -0,0 +1,191 @@
+package privatebtc_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/adrianbrad/privatebtc"
+	"github.com/adrianbrad/privatebtc/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelativeLock(t *testing.T) {
+	t.Parallel()
+
+	req := require.New(t)
+
+	req.Equal(uint32(144), privatebtc.RelativeLockBlocks(144))
+	req.Equal(uint32(1<<22|1), privatebtc.RelativeLockTime(time.Second))
+	req.Equal(uint32(1<<22|2), privatebtc.RelativeLockTime(1024*time.Second))
+	req.Equal(uint32(1<<22|3), privatebtc.RelativeLockTime(1025*time.Second))
+	req.Equal(uint32(1<<22|0xffff), privatebtc.RelativeLockTime(10_000*time.Hour))
+}
+
+// nolint: funlen
+func TestTimelockedAddress(t *testing.T) {
+	t.Parallel()
+
+	const key = "tprv8ZgxMBicQKsPd/84h/1h/0h/0"
+
+	tests := map[string]struct {
+		relative           bool
+		timelock           uint32
+		addressDescriptor  string
+		withoutDescriptors bool
+		expectedDescriptor string
+		expectedInput      privatebtc.TransactionVin
+		expectedLockTime   uint32
+		expectedError      error
+	}{
+		"CLTV": {
+			timelock:           150,
+			expectedDescriptor: "wsh(and_v(v:pk(" + key + "/5),after(150)))",
+			expectedInput:      privatebtc.TransactionVin{TxID: "funding", Vout: 1},
+			expectedLockTime:   150,
+		},
+		"CSV": {
+			relative:           true,
+			timelock:           privatebtc.RelativeLockBlocks(10),
+			expectedDescriptor: "wsh(and_v(v:pk(" + key + "/5),older(10)))",
+			expectedInput:      privatebtc.TransactionVin{TxID: "funding", Vout: 1, Sequence: 10},
+		},
+		"ZeroTimelock": {
+			timelock:      0,
+			expectedError: privatebtc.ErrInvalidTimelock,
+		},
+		"DisabledSequence": {
+			relative:      true,
+			timelock:      1 << 31,
+			expectedError: privatebtc.ErrInvalidTimelock,
+		},
+		"KeyNotFound": {
+			timelock:           150,
+			withoutDescriptors: true,
+			expectedError:      privatebtc.ErrTimelockKeyNotFound,
+		},
+		"AddressNotWPKH": {
+			timelock:          150,
+			addressDescriptor: "pkh([fp/44h/1h/0h/0/5]02pub)#pkh",
+			expectedError:     privatebtc.ErrTimelockKeyNotFound,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := require.New(t)
+
+			var (
+				imported []privatebtc.ImportDescriptorRequest
+				sent     []string
+			)
+
+			addressDescriptor := test.addressDescriptor
+			if addressDescriptor == "" {
+				addressDescriptor = "wpkh([fp/84h/1h/0h/0/5]02pub)#wpkh"
+			}
+
+			client := &mock.RPCClient{
+				GetNewAddressFunc: func(context.Context, string) (string, error) {
+					return "reserved", nil
+				},
+				GetAddressInfoFunc: func(_ context.Context, address string) (*privatebtc.AddressInfo, error) {
+					req.Equal("reserved", address)
+
+					return &privatebtc.AddressInfo{
+						Address:    address,
+						IsMine:     true,
+						Descriptor: addressDescriptor,
+						HDKeyPath:  "m/84h/1h/0h/0/5",
+					}, nil
+				},
+				ListDescriptorsFunc: func(_ context.Context, private bool) ([]privatebtc.Descriptor, error) {
+					req.True(private)
+
+					if test.withoutDescriptors {
+						return nil, nil
+					}
+
+					return []privatebtc.Descriptor{
+						{Desc: "pkh(" + key + "/*)#pkh", Active: false},
+						{Desc: "wpkh(" + key + "/*)#recv", Active: true, Next: 6},
+						{Desc: "wpkh(tprv8ZgxMBicQKsPd/84h/1h/0h/1/*)#chng", Active: true, Internal: true},
+					}, nil
+				},
+				GetDescriptorInfoFunc: func(context.Context, string) (*privatebtc.DescriptorInfo, error) {
+					return &privatebtc.DescriptorInfo{Descriptor: "public#pub", Checksum: "chk"}, nil
+				},
+				ImportDescriptorsFunc: func(_ context.Context, requests []privatebtc.ImportDescriptorRequest) error {
+					imported = requests
+
+					return nil
+				},
+				DeriveAddressFunc: func(_ context.Context, descriptor string) (string, error) {
+					req.Equal("public#pub", descriptor)
+
+					return "timelocked", nil
+				},
+				WalletCreateFundedPSBTFunc: func(
+					_ context.Context,
+					inputs []privatebtc.TransactionVin,
+					amounts map[string]privatebtc.Amount,
+					opts privatebtc.SendOptions,
+				) (*privatebtc.FundedPSBT, error) {
+					req.Equal([]privatebtc.TransactionVin{test.expectedInput}, inputs)
+					req.Equal(map[string]privatebtc.Amount{"receiver": privatebtc.BTC}, amounts)
+					req.Equal(privatebtc.SendOptions{
+						SubtractFeeFromAmount: true,
+						LockTime:              test.expectedLockTime,
+					}, opts)
+
+					return &privatebtc.FundedPSBT{PSBT: "psbt"}, nil
+				},
+				WalletProcessPSBTFunc: func(_ context.Context, psbt string, _ bool) (*privatebtc.ProcessedPSBT, error) {
+					return &privatebtc.ProcessedPSBT{PSBT: psbt + "_signed", Complete: true}, nil
+				},
+				FinalizePSBTFunc: func(context.Context, string) (*privatebtc.FinalizedPSBT, error) {
+					return &privatebtc.FinalizedPSBT{Hex: "txhex", Complete: true}, nil
+				},
+				SendRawTransactionFunc: func(_ context.Context, txHex string) (string, error) {
+					sent = append(sent, txHex)
+
+					return "spend", nil
+				},
+			}
+
+			newAddress := privatebtc.NewCLTVAddress
+			if test.relative {
+				newAddress = privatebtc.NewCSVAddress
+			}
+
+			addr, err := newAddress(context.Background(), client, test.timelock)
+			req.ErrorIs(err, test.expectedError)
+
+			if test.expectedError != nil {
+				req.Empty(imported)
+
+				return
+			}
+
+			req.Equal("timelocked", addr.Address())
+			req.Equal("public#pub", addr.Descriptor())
+			req.Equal([]privatebtc.ImportDescriptorRequest{
+				{Desc: test.expectedDescriptor + "#chk", Label: "timelock"},
+			}, imported)
+
+			txHash, err := addr.Spend(
+				context.Background(),
+				privatebtc.UTXO{TxID: "funding", Vout: 1, Address: "timelocked", Amount: privatebtc.BTC},
+				"receiver",
+			)
+			req.NoError(err)
+
+			req.Equal("spend", txHash)
+			req.Equal([]string{"txhex"}, sent)
+		})
+	}
+}
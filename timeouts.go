@@ -0,0 +1,74 @@
+package privatebtc
+
+import "time"
+
+// Timeout Policy
+// Every long-running operation of the private network is bounded by its own timeout:
+// - node creation, the node service creating the nodes.
+// - RPC warm-up, creating the RPC client and the wallet of every node, the deadline
+// is applied to each node individually.
+// - peer connection, connecting the nodes to their peers.
+// - sync, waiting for the nodes to sync to a block.
+// - assertion, the peer count checks performed by ChainReorgWithAssertion.
+// WithTimeout configures all of them at once, the operation specific options take
+// precedence over it regardless of the order they are given in.
+
+type timeoutOperation int
+
+const (
+	createNodesTimeout timeoutOperation = iota
+	rpcWarmUpTimeout
+	connectTimeout
+	syncTimeout
+	assertionTimeout
+)
+
+type timeouts struct {
+	createNodes time.Duration
+	rpcWarmUp   time.Duration
+	connect     time.Duration
+	sync        time.Duration
+	assertion   time.Duration
+}
+
+func defaultTimeouts() timeouts {
+	return timeouts{
+		createNodes: 2 * time.Minute,
+		rpcWarmUp:   30 * time.Second,
+		connect:     30 * time.Second,
+		sync:        5 * time.Second,
+		assertion:   time.Second,
+	}
+}
+
+// timeouts resolves the timeout of every operation from the configured options.
+func (o *options) timeouts() timeouts {
+	t := defaultTimeouts()
+
+	if o.timeout != nil {
+		t = timeouts{
+			createNodes: *o.timeout,
+			rpcWarmUp:   *o.timeout,
+			connect:     *o.timeout,
+			sync:        *o.timeout,
+			assertion:   *o.timeout,
+		}
+	}
+
+	for op, timeout := range o.operationTimeouts {
+		switch op {
+		case createNodesTimeout:
+			t.createNodes = timeout
+		case rpcWarmUpTimeout:
+			t.rpcWarmUp = timeout
+		case connectTimeout:
+			t.connect = timeout
+		case syncTimeout:
+			t.sync = timeout
+		case assertionTimeout:
+			t.assertion = timeout
+		}
+	}
+
+	return t
+}
@@ -0,0 +1,160 @@
+package privatebtc_test
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/adrianbrad/privatebtc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimeouts(t *testing.T) {
+	t.Parallel()
+
+	type deadlines struct {
+		createNodes time.Duration
+		rpcWarmUp   time.Duration
+	}
+
+	tests := map[string]struct {
+		opts     []privatebtc.Option
+		expected deadlines
+	}{
+		"Default": {
+			opts: nil,
+			expected: deadlines{
+				createNodes: 2 * time.Minute,
+				rpcWarmUp:   30 * time.Second,
+			},
+		},
+		"Global": {
+			opts: []privatebtc.Option{privatebtc.WithTimeout(time.Hour)},
+			expected: deadlines{
+				createNodes: time.Hour,
+				rpcWarmUp:   time.Hour,
+			},
+		},
+		"OperationOverridesGlobal": {
+			opts: []privatebtc.Option{
+				privatebtc.WithCreateNodesTimeout(time.Minute),
+				privatebtc.WithTimeout(time.Hour),
+				privatebtc.WithRPCWarmUpTimeout(10 * time.Second),
+			},
+			expected: deadlines{
+				createNodes: time.Minute,
+				rpcWarmUp:   10 * time.Second,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := require.New(t)
+
+			var (
+				mu  sync.Mutex
+				got deadlines
+			)
+
+			remaining := func(ctx context.Context) time.Duration {
+				deadline, ok := ctx.Deadline()
+				req.True(ok)
+
+				return time.Until(deadline)
+			}
+
+			nodeService := newPrivateNetworkStartSuccessDockerService(
+				newPrivateNetworkStartSuccessNodeHandler(),
+			)
+			createNodes := nodeService.CreateNodesFunc
+
+			nodeService.CreateNodesFunc = func(
+				ctx context.Context,
+				nodeRequests []privatebtc.CreateNodeRequest,
+			) ([]privatebtc.NodeHandler, error) {
+				got.createNodes = remaining(ctx)
+
+				return createNodes(ctx, nodeRequests)
+			}
+
+			c := newChainReorgSuccessRPCClient(nil)
+
+			c.CreateWalletFunc = func(ctx context.Context, _ string) error {
+				mu.Lock()
+				defer mu.Unlock()
+
+				got.rpcWarmUp = remaining(ctx)
+
+				return nil
+			}
+
+			pn, err := privatebtc.NewPrivateNetwork(
+				nodeService,
+				newPrivateNetworkStartSuccessRPCClientFactory(c),
+				1,
+				append(test.opts, privatebtc.WithWallet("wallet"))...,
+			)
+			req.NoError(err)
+
+			req.NoError(pn.Start(context.Background()))
+
+			const delta = 5 * time.Second
+
+			req.InDelta(test.expected.createNodes, got.createNodes, float64(delta))
+			req.InDelta(test.expected.rpcWarmUp, got.rpcWarmUp, float64(delta))
+		})
+	}
+
+	t.Run("AssertionTimeout", func(t *testing.T) {
+		t.Parallel()
+
+		req := require.New(t)
+
+		c := newChainReorgSuccessRPCClient(nil)
+
+		var started atomic.Bool
+
+		getConnectionCount := c.GetConnectionCountFunc
+
+		// the disconnected node never drops its peer.
+		c.GetConnectionCountFunc = func(ctx context.Context) (int, error) {
+			if started.Load() {
+				return 1, nil
+			}
+
+			return getConnectionCount(ctx)
+		}
+
+		pn, err := privatebtc.NewPrivateNetwork(
+			newPrivateNetworkStartSuccessDockerService(
+				newPrivateNetworkStartSuccessNodeHandler(),
+				newPrivateNetworkStartSuccessNodeHandler(),
+			),
+			newPrivateNetworkStartSuccessRPCClientFactory(c),
+			2,
+			privatebtc.WithAssertionTimeout(300*time.Millisecond),
+		)
+		req.NoError(err)
+
+		req.NoError(pn.Start(context.Background()))
+
+		started.Store(true)
+
+		crm, err := pn.NewChainReorgWithAssertion(0)
+		req.NoError(err)
+
+		start := time.Now()
+
+		_, err = crm.DisconnectNode(context.Background())
+		req.ErrorContains(err, "disconnected node: unexpected peer count")
+
+		req.Less(time.Since(start), time.Second)
+	})
+}
@@ -0,0 +1,239 @@
+package privatebtc
+
+import (
+	"fmt"
+
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+// Topology defines which nodes of the private network are peers of each other.
+// The topology is resolved when the private network is created, once the number of
+// nodes is known.
+type Topology interface {
+	links(nodes int) ([]link, error)
+}
+
+// link is an undirected peer connection between two nodes, identified by their IDs.
+type link struct {
+	from int
+	to   int
+}
+
+type fullMeshTopology struct{}
+
+func (fullMeshTopology) links(nodes int) ([]link, error) {
+	var links []link
+
+	for i := 0; i < nodes; i++ {
+		for j := i + 1; j < nodes; j++ {
+			links = append(links, link{from: i, to: j})
+		}
+	}
+
+	return links, nil
+}
+
+// FullMeshTopology connects every node to every other node.
+// This is the default topology of a private network.
+func FullMeshTopology() Topology {
+	return fullMeshTopology{}
+}
+
+type lineTopology struct{}
+
+func (lineTopology) links(nodes int) ([]link, error) {
+	var links []link
+
+	for i := 0; i+1 < nodes; i++ {
+		links = append(links, link{from: i, to: i + 1})
+	}
+
+	return links, nil
+}
+
+// LineTopology connects every node only to the nodes with the previous and next IDs,
+// 0 <-> 1 <-> 2 <-> ... <-> n-1.
+func LineTopology() Topology {
+	return lineTopology{}
+}
+
+type ringTopology struct{}
+
+func (ringTopology) links(nodes int) ([]link, error) {
+	links, _ := lineTopology{}.links(nodes)
+
+	// with less than 3 nodes the ring is the same as the line.
+	const minRingNodes = 3
+
+	if nodes >= minRingNodes {
+		links = append(links, link{from: 0, to: nodes - 1})
+	}
+
+	return links, nil
+}
+
+// RingTopology connects the nodes in a line and then closes the line by connecting
+// the last node to the first one.
+func RingTopology() Topology {
+	return ringTopology{}
+}
+
+type starTopology int
+
+func (s starTopology) links(nodes int) ([]link, error) {
+	center := int(s)
+
+	if center < 0 || center >= nodes {
+		return nil, fmt.Errorf("star center %d: %w", center, ErrNodeIndexOutOfRange)
+	}
+
+	links := make([]link, 0, nodes)
+
+	for i := 0; i < nodes; i++ {
+		if i == center {
+			continue
+		}
+
+		links = append(links, newLink(center, i))
+	}
+
+	return links, nil
+}
+
+// StarTopology connects the center node to every other node,
+// the other nodes are not connected to each other.
+func StarTopology(center int) Topology {
+	return starTopology(center)
+}
+
+type adjacencyListTopology map[int][]int
+
+func (a adjacencyListTopology) links(nodes int) ([]link, error) {
+	set := map[link]struct{}{}
+
+	for node, peers := range a {
+		if node < 0 || node >= nodes {
+			return nil, fmt.Errorf("node %d: %w", node, ErrNodeIndexOutOfRange)
+		}
+
+		for _, peer := range peers {
+			if peer < 0 || peer >= nodes {
+				return nil, fmt.Errorf("peer %d of node %d: %w", peer, node, ErrNodeIndexOutOfRange)
+			}
+
+			if peer == node {
+				return nil, fmt.Errorf("node %d: %w", node, ErrNodeCannotPeerWithItself)
+			}
+
+			set[newLink(node, peer)] = struct{}{}
+		}
+	}
+
+	links := maps.Keys(set)
+
+	sortLinks(links)
+
+	return links, nil
+}
+
+// AdjacencyListTopology connects the nodes as described by the given adjacency list.
+// The keys are node IDs and the values are the IDs of their peers.
+// Connections are undirected, listing a peer once for either of the two nodes is enough.
+func AdjacencyListTopology(adjacencyList map[int][]int) Topology {
+	return adjacencyListTopology(adjacencyList)
+}
+
+func newLink(a, b int) link {
+	if a > b {
+		a, b = b, a
+	}
+
+	return link{from: a, to: b}
+}
+
+func sortLinks(links []link) {
+	slices.SortFunc(links, func(a, b link) int {
+		if a.from != b.from {
+			return a.from - b.from
+		}
+
+		return a.to - b.to
+	})
+}
+
+// peerGraph holds the peers of every node, indexed by node ID.
+type peerGraph map[int]map[int]struct{}
+
+func newPeerGraph(nodes int, links []link) peerGraph {
+	g := make(peerGraph, nodes)
+
+	for i := 0; i < nodes; i++ {
+		g[i] = map[int]struct{}{}
+	}
+
+	for _, l := range links {
+		g.connect(l.from, l.to)
+	}
+
+	return g
+}
+
+func (g peerGraph) connect(a, b int) {
+	if g[a] == nil {
+		g[a] = map[int]struct{}{}
+	}
+
+	if g[b] == nil {
+		g[b] = map[int]struct{}{}
+	}
+
+	g[a][b] = struct{}{}
+	g[b][a] = struct{}{}
+}
+
+// remove removes the node with the given ID and all its connections from the graph.
+func (g peerGraph) remove(id int) {
+	for peer := range g[id] {
+		delete(g[peer], id)
+	}
+
+	delete(g, id)
+}
+
+// clone returns a deep copy of the graph.
+func (g peerGraph) clone() peerGraph {
+	c := make(peerGraph, len(g))
+
+	for id, peers := range g {
+		c[id] = maps.Clone(peers)
+	}
+
+	return c
+}
+
+// peers returns the sorted IDs of the peers of the given node.
+func (g peerGraph) peers(id int) []int {
+	peers := maps.Keys(g[id])
+
+	slices.Sort(peers)
+
+	return peers
+}
+
+// links returns every connection in the graph, sorted.
+func (g peerGraph) links() []link {
+	var links []link
+
+	for a, peers := range g {
+		for b := range peers {
+			if a < b {
+				links = append(links, link{from: a, to: b})
+			}
+		}
+	}
+
+	sortLinks(links)
+
+	return links
+}
@@ -0,0 +1,168 @@
+package privatebtc_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/adrianbrad/privatebtc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTopology(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Start", func(t *testing.T) {
+		t.Parallel()
+
+		tests := map[string]struct {
+			nodes          int
+			topology       privatebtc.Topology
+			expectedLinks  [][2]int
+			errorAssertion require.ErrorAssertionFunc
+		}{
+			"FullMesh": {
+				nodes:          3,
+				topology:       privatebtc.FullMeshTopology(),
+				expectedLinks:  [][2]int{{0, 1}, {0, 2}, {1, 2}},
+				errorAssertion: require.NoError,
+			},
+			"Nil": {
+				nodes:          3,
+				topology:       nil,
+				expectedLinks:  [][2]int{{0, 1}, {0, 2}, {1, 2}},
+				errorAssertion: require.NoError,
+			},
+			"Line": {
+				nodes:          4,
+				topology:       privatebtc.LineTopology(),
+				expectedLinks:  [][2]int{{0, 1}, {1, 2}, {2, 3}},
+				errorAssertion: require.NoError,
+			},
+			"Ring": {
+				nodes:          4,
+				topology:       privatebtc.RingTopology(),
+				expectedLinks:  [][2]int{{0, 1}, {0, 3}, {1, 2}, {2, 3}},
+				errorAssertion: require.NoError,
+			},
+			"Star": {
+				nodes:          4,
+				topology:       privatebtc.StarTopology(2),
+				expectedLinks:  [][2]int{{0, 2}, {1, 2}, {2, 3}},
+				errorAssertion: require.NoError,
+			},
+			"AdjacencyList": {
+				nodes: 4,
+				topology: privatebtc.AdjacencyListTopology(map[int][]int{
+					0: {1, 3},
+					3: {0},
+				}),
+				expectedLinks:  [][2]int{{0, 1}, {0, 3}},
+				errorAssertion: require.NoError,
+			},
+		}
+
+		for name, test := range tests {
+			test := test
+
+			t.Run(name, func(t *testing.T) {
+				t.Parallel()
+
+				req := require.New(t)
+
+				mocks := newMockNodes(nil)
+
+				pn, err := privatebtc.NewPrivateNetwork(
+					mocks.nodeService(test.nodes),
+					mocks.rpcClientFactory(),
+					test.nodes,
+					privatebtc.WithTopology(test.topology),
+				)
+				req.NoError(err)
+
+				err = pn.Start(context.Background())
+				test.errorAssertion(t, err)
+
+				req.Equal(test.expectedLinks, mocks.sortedAdded())
+			})
+		}
+	})
+
+	t.Run("InvalidTopology", func(t *testing.T) {
+		t.Parallel()
+
+		tests := map[string]struct {
+			topology       privatebtc.Topology
+			errorAssertion require.ErrorAssertionFunc
+		}{
+			"StarCenterOutOfRange": {
+				topology: privatebtc.StarTopology(3),
+				errorAssertion: func(t require.TestingT, err error, i ...any) {
+					require.ErrorIs(t, err, privatebtc.ErrNodeIndexOutOfRange, i...)
+				},
+			},
+			"AdjacencyListPeerOutOfRange": {
+				topology: privatebtc.AdjacencyListTopology(map[int][]int{0: {5}}),
+				errorAssertion: func(t require.TestingT, err error, i ...any) {
+					require.ErrorIs(t, err, privatebtc.ErrNodeIndexOutOfRange, i...)
+				},
+			},
+			"AdjacencyListSelfPeer": {
+				topology: privatebtc.AdjacencyListTopology(map[int][]int{1: {1}}),
+				errorAssertion: func(t require.TestingT, err error, i ...any) {
+					require.ErrorIs(t, err, privatebtc.ErrNodeCannotPeerWithItself, i...)
+				},
+			},
+		}
+
+		for name, test := range tests {
+			test := test
+
+			t.Run(name, func(t *testing.T) {
+				t.Parallel()
+
+				_, err := privatebtc.NewPrivateNetwork(
+					nil,
+					nil,
+					3,
+					privatebtc.WithTopology(test.topology),
+				)
+				test.errorAssertion(t, err)
+			})
+		}
+	})
+
+	t.Run("DisconnectAndConnectRespectTopology", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		req := require.New(t)
+
+		mocks := newMockNodes(nil)
+
+		const nodes = 4
+
+		pn, err := privatebtc.NewPrivateNetwork(
+			mocks.nodeService(nodes),
+			mocks.rpcClientFactory(),
+			nodes,
+			privatebtc.WithTopology(privatebtc.LineTopology()),
+		)
+		req.NoError(err)
+
+		err = pn.Start(ctx)
+		req.NoError(err)
+
+		err = pn.Nodes()[1].DisconnectFromNetwork(ctx)
+		req.NoError(err)
+
+		req.Equal([][2]int{{0, 1}, {2, 1}}, mocks.sortedRemoved())
+
+		err = pn.Nodes()[1].ConnectToNetwork(ctx)
+		req.NoError(err)
+
+		req.Equal(
+			[][2]int{{0, 1}, {0, 1}, {1, 2}, {2, 1}, {2, 3}},
+			mocks.sortedAdded(),
+		)
+	})
+}
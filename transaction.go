@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	"golang.org/x/sync/errgroup"
 )
@@ -18,8 +19,8 @@ type Transaction struct {
 }
 
 // GetTransactionFee returns the transaction fee.
-func (tx Transaction) GetTransactionFee(totalInputs float64) float64 {
-	var totalOutputs float64
+func (tx Transaction) GetTransactionFee(totalInputs Amount) Amount {
+	var totalOutputs Amount
 
 	for _, vout := range tx.Vout {
 		totalOutputs += vout.Value
@@ -30,8 +31,8 @@ func (tx Transaction) GetTransactionFee(totalInputs float64) float64 {
 
 // TotalInputsValue sums up the value of all inputs in the transaction by checking the value of
 // the outputs that they spend from.
-func (tx Transaction) TotalInputsValue(ctx context.Context, client RPCClient) (float64, error) {
-	var totalInputs float64
+func (tx Transaction) TotalInputsValue(ctx context.Context, client RPCClient) (Amount, error) {
+	var totalInputs Amount
 
 	// sync
 	var (
@@ -71,11 +72,15 @@ func (tx Transaction) TotalInputsValue(ctx context.Context, client RPCClient) (f
 type TransactionVin struct {
 	TxID string
 	Vout uint32
+	// Sequence is the nSequence of the input, e.g. a relative timelock, when
+	// building a transaction. The node default is used when zero.
+	// It is not set for the inputs of the transactions returned by the node.
+	Sequence uint32
 }
 
 // TransactionVout represents a BTC transaction output.
 type TransactionVout struct {
-	Value        float64
+	Value        Amount
 	N            uint32
 	ScriptPubKey struct {
 		Address string
@@ -88,8 +93,38 @@ type MempoolTransaction struct {
 	Outputs []MempoolTransactionOutput
 }
 
+// MempoolEntry is a transaction as seen by the mempool of a node.
+type MempoolEntry struct {
+	// VSize is the virtual size of the transaction, in vbytes.
+	VSize int
+	// Fee is the transaction fee, without the fee deltas prioritising the transaction.
+	Fee Amount
+	// Time is the time the transaction entered the mempool.
+	Time time.Time
+	// AncestorCount is the number of in-mempool ancestors, including the transaction.
+	AncestorCount int
+	// AncestorSize is the virtual size of the in-mempool ancestors, including the transaction.
+	AncestorSize int
+	// AncestorFees are the fees of the in-mempool ancestors, including the transaction.
+	AncestorFees Amount
+	// DescendantCount is the number of in-mempool descendants, including the transaction.
+	DescendantCount int
+	// DescendantSize is the virtual size of the in-mempool descendants, including the transaction.
+	DescendantSize int
+	// DescendantFees are the fees of the in-mempool descendants, including the transaction.
+	DescendantFees Amount
+	// BIP125Replaceable reports whether the transaction, or one of its unconfirmed
+	// ancestors, signals BIP 125 replaceability.
+	BIP125Replaceable bool
+}
+
+// FeeRate returns the fee rate of the transaction.
+func (e MempoolEntry) FeeRate() FeeRate {
+	return NewFeeRate(e.Fee, e.VSize)
+}
+
 // MempoolTransactionOutput represents a BTC mempool transaction output.
 type MempoolTransactionOutput struct {
 	Address string
-	Value   float64
+	Value   Amount
 }
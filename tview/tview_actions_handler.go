@@ -34,17 +34,70 @@ func (a *actionsHandler) handleCreateAddress(nodeID int) (string, error) {
 	return addr, nil
 }
 
+// sendOptionsInput is the send options as entered in the send form,
+// the empty fields are left to the node.
+type sendOptionsInput struct {
+	feeRate       string
+	confTarget    string
+	subtractFee   bool
+	replaceable   bool
+	changeAddress string
+}
+
+func (in sendOptionsInput) parse() (privatebtc.SendOptions, error) {
+	opts := privatebtc.SendOptions{
+		SubtractFeeFromAmount: in.subtractFee,
+		Replaceable:           in.replaceable,
+		ChangeAddress:         in.changeAddress,
+	}
+
+	if in.feeRate != "" {
+		feeRate, err := parseFeeRate(in.feeRate)
+		if err != nil {
+			return privatebtc.SendOptions{}, err
+		}
+
+		opts.FeeRate = feeRate
+	}
+
+	if in.confTarget != "" {
+		confTarget, err := strconv.Atoi(in.confTarget)
+		if err != nil {
+			return privatebtc.SendOptions{}, fmt.Errorf("parse conf target: %w", err)
+		}
+
+		opts.ConfTarget = confTarget
+	}
+
+	if err := opts.Validate(); err != nil {
+		return privatebtc.SendOptions{}, err
+	}
+
+	return opts, nil
+}
+
 func (a *actionsHandler) handleSendToAddress(
 	nodeID int,
 	address,
 	amount string,
+	optsInput sendOptionsInput,
 ) (string, error) {
 	amountBTC, err := strconv.ParseFloat(amount, 64)
 	if err != nil {
 		return "", fmt.Errorf("parse amount: %w", err)
 	}
 
-	txHash, err := a.btcpn.Nodes()[nodeID].RPCClient().SendToAddress(ctx, address, amountBTC)
+	am, err := privatebtc.AmountFromBTC(amountBTC)
+	if err != nil {
+		return "", fmt.Errorf("amount from btc: %w", err)
+	}
+
+	opts, err := optsInput.parse()
+	if err != nil {
+		return "", err
+	}
+
+	txHash, err := a.btcpn.Nodes()[nodeID].RPCClient().SendToAddress(ctx, address, am, opts)
 	if err != nil {
 		return "", fmt.Errorf("send to address: %w", err)
 	}
@@ -103,3 +156,54 @@ func (a *actionsHandler) replaceByFeeDrainToAddress(
 
 	return txHash, nil
 }
+
+func (a *actionsHandler) bumpFee(
+	nodeID int,
+	txID string,
+	feeRate string,
+) (string, error) {
+	rate, err := parseFeeRate(feeRate)
+	if err != nil {
+		return "", err
+	}
+
+	node := a.btcpn.Nodes()[nodeID]
+
+	txHash, err := privatebtc.BumpFee(ctx, node.RPCClient(), txID, rate)
+	if err != nil {
+		return "", fmt.Errorf("bump fee: %w", err)
+	}
+
+	return txHash, nil
+}
+
+func (a *actionsHandler) childPaysForParent(
+	nodeID int,
+	txID string,
+	vout int,
+	feeRate string,
+	address string,
+) (string, error) {
+	rate, err := parseFeeRate(feeRate)
+	if err != nil {
+		return "", err
+	}
+
+	node := a.btcpn.Nodes()[nodeID]
+
+	txHash, err := privatebtc.ChildPaysForParent(ctx, node.RPCClient(), txID, uint32(vout), address, rate)
+	if err != nil {
+		return "", fmt.Errorf("child pays for parent: %w", err)
+	}
+
+	return txHash, nil
+}
+
+func parseFeeRate(feeRate string) (privatebtc.FeeRate, error) {
+	rate, err := strconv.ParseFloat(feeRate, 64)
+	if err != nil {
+		return 0, fmt.Errorf("parse fee rate: %w", err)
+	}
+
+	return privatebtc.FeeRate(rate), nil
+}
@@ -3,6 +3,7 @@ package tview
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/adrianbrad/privatebtc"
 )
@@ -15,7 +16,38 @@ func (s mempoolTxDetailsOutputs) String() string {
 	b.WriteString("\n")
 
 	for _, output := range s {
-		b.WriteString(fmt.Sprintf("%s: %f\n", output.Address, output.Value))
+		b.WriteString(fmt.Sprintf("%s: %f\n", output.Address, output.Value.ToBTC()))
+	}
+
+	return b.String()
+}
+
+type mempoolTxDetailsEntries struct {
+	nodes   []int
+	entries map[int]*privatebtc.MempoolEntry
+}
+
+func (s mempoolTxDetailsEntries) String() string {
+	var b strings.Builder
+
+	for _, node := range s.nodes {
+		entry := s.entries[node]
+		if entry == nil {
+			continue
+		}
+
+		b.WriteString(fmt.Sprintf(
+			"\nNode %d:\n"+
+				"  Fee: %f (%d vB, %s)\n"+
+				"  Entered: %s\n"+
+				"  Ancestors: %d Descendants: %d\n"+
+				"  BIP125 Replaceable: %t\n",
+			node,
+			entry.Fee.ToBTC(), entry.VSize, entry.FeeRate(),
+			entry.Time.Format(time.TimeOnly),
+			entry.AncestorCount, entry.DescendantCount,
+			entry.BIP125Replaceable,
+		))
 	}
 
 	return b.String()
@@ -25,10 +57,11 @@ type mempoolTxDetails privatebtc.NetworkMempoolTransaction
 
 func (m mempoolTxDetails) String() string {
 	return fmt.Sprintf(
-		"Hash: %s\nNodes: %v\nOutputs: %v",
+		"Hash: %s\nNodes: %v\nOutputs: %v\nEntries: %v",
 		m.Hash,
 		m.Nodes,
 		mempoolTxDetailsOutputs(m.Outputs),
+		mempoolTxDetailsEntries{nodes: m.Nodes, entries: m.Entries},
 	)
 }
 
@@ -51,7 +84,7 @@ func (n nodeDetails) String() string {
 		n.id,
 		n.connected,
 		n.blockCount,
-		n.balance.Trusted, n.balance.Pending, n.balance.Immature,
+		n.balance.Trusted.ToBTC(), n.balance.Pending.ToBTC(), n.balance.Immature.ToBTC(),
 		strings.Join(n.addresses, "\n"),
 		strings.Join(n.mempoolTxs, "\n"),
 	)
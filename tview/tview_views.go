@@ -7,7 +7,6 @@ import (
 	"time"
 
 	"github.com/adrianbrad/privatebtc"
-	"github.com/btcsuite/btcd/btcutil"
 	"github.com/rivo/tview"
 )
 
@@ -147,6 +146,8 @@ type nodeActionsList struct {
 	sendBitcoinForm   *sendBitcoinForm
 	mineBlocksForm    *mineBlocksForm
 	rbfDrainToAddress *replaceByFeeDrainToAddressForm
+	bumpFee           *bumpFeeForm
+	cpfp              *childPaysForParentForm
 }
 
 // nolint: gocognit
@@ -180,6 +181,20 @@ func newNodeActionsList(
 		nodesList,
 	)
 
+	bumpFee := newBumpFeeForm(
+		appPages,
+		actionsHandler,
+		outputView,
+		nodesList,
+	)
+
+	cpfp := newChildPaysForParentForm(
+		appPages,
+		actionsHandler,
+		outputView,
+		nodesList,
+	)
+
 	list := tview.NewList()
 
 	list.
@@ -189,6 +204,8 @@ func newNodeActionsList(
 		AddItem("Disconnect from Network", "", 0, nil).
 		AddItem("Connect to Network", "", 0, nil).
 		AddItem("Replace By Fee Drain To Address", "", 0, nil).
+		AddItem("Bump Fee", "", 0, nil).
+		AddItem("Child Pays For Parent", "", 0, nil).
 		SetSelectedFunc(func(actionIndex int, _, _ string, _ rune) {
 			currentNodeIndex := nodesList.GetCurrentItem()
 
@@ -227,7 +244,7 @@ func newNodeActionsList(
 					fmt.Sprintf(
 						"Node %d Balance: %.2f",
 						currentNodeIndex,
-						data.nodesDetails[currentNodeIndex].balance.Trusted,
+						data.nodesDetails[currentNodeIndex].balance.Trusted.ToBTC(),
 					),
 				)
 
@@ -272,7 +289,7 @@ func newNodeActionsList(
 				}
 
 				mineBlocksForm.GetFormItem(4).(*tview.TextView).SetText(
-					fmt.Sprintf("%.2f BTC", btcutil.Amount(coinbase).ToBTC()),
+					fmt.Sprintf("%.2f BTC", coinbase.ToBTC()),
 				)
 
 				mineBlocksForm.GetFormItem(1).(*tview.DropDown).
@@ -365,7 +382,7 @@ func newNodeActionsList(
 				}
 
 				rbfDrainToAddress.GetFormItem(2).(*tview.TextView).
-					SetText(fmt.Sprintf("%.2f", totalInputs))
+					SetText(fmt.Sprintf("%.2f", totalInputs.ToBTC()))
 
 				rbfDrainToAddress.GetFormItem(3).(*tview.DropDown).
 					SetOptions(
@@ -385,6 +402,75 @@ func newNodeActionsList(
 								SetText(addr)
 						}).
 					SetCurrentOption(-1)
+			case 6: // Bump fee
+				if mempoolTxList.List.GetItemCount() == 0 {
+					outputView.AddError("no transactions in mempool")
+					return
+				}
+
+				appPages.ShowPage("bumpFeeForm")
+
+				bumpFee.GetFormItem(0).(*tview.TextView).SetText(
+					fmt.Sprintf("Node %d", currentNodeIndex),
+				)
+
+				txID, _ := mempoolTxList.GetItemText(mempoolTxList.GetCurrentItem())
+
+				bumpFee.GetFormItem(1).(*tview.TextView).SetText(txID)
+			case 7: // Child pays for parent
+				if mempoolTxList.List.GetItemCount() == 0 {
+					outputView.AddError("no transactions in mempool")
+					return
+				}
+
+				txID, _ := mempoolTxList.GetItemText(mempoolTxList.GetCurrentItem())
+
+				outputs, err := actionsHandler.btcpn.Nodes()[currentNodeIndex].RPCClient().
+					GetTransactionOutputs(ctx, txID)
+				if err != nil {
+					outputView.AddError(fmt.Sprintf(
+						"retrieve outputs for tx %q: %v",
+						txID,
+						err,
+					))
+
+					return
+				}
+
+				appPages.ShowPage("childPaysForParentForm")
+
+				cpfp.GetFormItem(0).(*tview.TextView).SetText(
+					fmt.Sprintf("Node %d", currentNodeIndex),
+				)
+
+				cpfp.GetFormItem(1).(*tview.TextView).SetText(txID)
+
+				outputOptions := make([]string, len(outputs))
+
+				for i, output := range outputs {
+					outputOptions[i] = fmt.Sprintf("%d: %s %.8f", i, output.Address, output.Value.ToBTC())
+				}
+
+				cpfp.GetFormItem(2).(*tview.DropDown).SetOptions(outputOptions, nil)
+
+				cpfp.GetFormItem(4).(*tview.DropDown).
+					SetOptions(
+						data.toFormAddresses(),
+						func(option string, i int) {
+							if i < 0 {
+								return
+							}
+
+							addr := burnAddress
+							if a := strings.Split(option, ":"); len(a) == 2 {
+								addr = a[1]
+							}
+
+							cpfp.
+								GetFormItem(5).(*tview.InputField).
+								SetText(addr)
+						}).
+					SetCurrentOption(-1)
 			}
 		}).
 		ShowSecondaryText(false).
@@ -398,6 +484,8 @@ func newNodeActionsList(
 		sendBitcoinForm:   sendBitcoinForm,
 		mineBlocksForm:    mineBlocksForm,
 		rbfDrainToAddress: rbfDrainToAddress,
+		bumpFee:           bumpFee,
+		cpfp:              cpfp,
 	}
 }
 
@@ -420,6 +508,11 @@ func newSendBitcoinForm(
 		labelAddresses       = "Addresses"
 		labelReceiverAddress = "Receiver Address"
 		labelAmount          = "Amount"
+		labelFeeRate         = "Fee Rate (sat/vB)"
+		labelConfTarget      = "Conf Target"
+		labelSubtractFee     = "Subtract Fee"
+		labelReplaceable     = "Replaceable"
+		labelChangeAddress   = "Change Address"
 	)
 
 	form.
@@ -451,6 +544,29 @@ func newSendBitcoinForm(
 			tview.InputFieldFloat,
 			nil,
 		).
+		AddInputField(
+			labelFeeRate,
+			"",
+			inputFieldWithd,
+			tview.InputFieldFloat,
+			nil,
+		).
+		AddInputField(
+			labelConfTarget,
+			"",
+			inputFieldWithd,
+			tview.InputFieldInteger,
+			nil,
+		).
+		AddCheckbox(labelSubtractFee, false, nil).
+		AddCheckbox(labelReplaceable, false, nil).
+		AddInputField(
+			labelChangeAddress,
+			"",
+			inputFieldWithd,
+			tview.InputFieldMaxLength(bitcoinMaxAddressLength),
+			nil,
+		).
 		AddButton("Send", func() {
 			defer hide()
 
@@ -458,12 +574,21 @@ func newSendBitcoinForm(
 
 			amount := form.GetFormItemByLabel(labelAmount).(*tview.InputField).GetText()
 
+			opts := sendOptionsInput{
+				feeRate:       form.GetFormItemByLabel(labelFeeRate).(*tview.InputField).GetText(),
+				confTarget:    form.GetFormItemByLabel(labelConfTarget).(*tview.InputField).GetText(),
+				subtractFee:   form.GetFormItemByLabel(labelSubtractFee).(*tview.Checkbox).IsChecked(),
+				replaceable:   form.GetFormItemByLabel(labelReplaceable).(*tview.Checkbox).IsChecked(),
+				changeAddress: form.GetFormItemByLabel(labelChangeAddress).(*tview.InputField).GetText(),
+			}
+
 			nodeID := nodesList.GetCurrentItem()
 
 			txHash, err := actionsHandler.handleSendToAddress(
 				nodeID,
 				addr,
 				amount,
+				opts,
 			)
 			if err != nil {
 				output.AddError(fmt.Sprintf(
@@ -694,6 +819,214 @@ func newReplaceByFeeDrainToAddressForm(
 	}
 }
 
+type bumpFeeForm struct {
+	*tview.Form
+}
+
+func newBumpFeeForm(
+	appPages *tview.Pages,
+	actionsHandler *actionsHandler,
+	output *outputView,
+	nodesList *nodesList,
+) *bumpFeeForm {
+	form := tview.NewForm()
+
+	hide := hideForm(appPages, form)
+
+	const (
+		labelNode    = "Node"
+		labelTxID    = "Transaction ID"
+		labelFeeRate = "Fee Rate (sat/vB)"
+	)
+
+	form.
+		AddTextView(
+			labelNode,
+			"",
+			0,
+			1,
+			true,
+			false,
+		).
+		AddTextView(
+			labelTxID,
+			"",
+			0,
+			1,
+			true,
+			false,
+		).
+		AddInputField(
+			labelFeeRate,
+			"",
+			inputFieldWithd,
+			tview.InputFieldFloat,
+			nil,
+		).
+		AddButton("Send", func() {
+			defer hide()
+
+			txID := form.GetFormItemByLabel(labelTxID).(*tview.TextView).GetText(false)
+			feeRate := form.GetFormItemByLabel(labelFeeRate).(*tview.InputField).GetText()
+
+			nodeID := nodesList.GetCurrentItem()
+
+			newTxID, err := actionsHandler.bumpFee(
+				nodeID,
+				txID,
+				feeRate,
+			)
+			if err != nil {
+				output.AddError(fmt.Sprintf(
+					"bump fee of tx %q to %s sat/vB, from node %d: %s",
+					txID,
+					feeRate,
+					nodeID,
+					err,
+				))
+				return
+			}
+
+			output.AddSuccess(fmt.Sprintf(
+				"replaced tx %q with tx %q paying %s sat/vB from node %d",
+				txID,
+				newTxID,
+				feeRate,
+				nodeID,
+			))
+		}).
+		AddButton("Cancel", hide).
+		SetCancelFunc(hide).
+		SetBorder(true).
+		SetTitle("Bump Fee")
+
+	return &bumpFeeForm{
+		Form: form,
+	}
+}
+
+type childPaysForParentForm struct {
+	*tview.Form
+}
+
+func newChildPaysForParentForm(
+	appPages *tview.Pages,
+	actionsHandler *actionsHandler,
+	output *outputView,
+	nodesList *nodesList,
+) *childPaysForParentForm {
+	form := tview.NewForm()
+
+	hide := hideForm(appPages, form)
+
+	const (
+		labelNode               = "Node"
+		labelTxID               = "Transaction ID"
+		labelOutput             = "Output"
+		labelFeeRate            = "Package Fee Rate (sat/vB)"
+		labelAddresses          = "Addresses"
+		labelDestinationAddress = "Destination Address"
+	)
+
+	form.
+		AddTextView(
+			labelNode,
+			"",
+			0,
+			1,
+			true,
+			false,
+		).
+		AddTextView(
+			labelTxID,
+			"",
+			0,
+			1,
+			true,
+			false,
+		).
+		AddDropDown(
+			labelOutput,
+			[]string{},
+			0,
+			nil,
+		).
+		AddInputField(
+			labelFeeRate,
+			"",
+			inputFieldWithd,
+			tview.InputFieldFloat,
+			nil,
+		).
+		AddDropDown(
+			labelAddresses,
+			[]string{},
+			0,
+			nil,
+		).
+		AddInputField(
+			labelDestinationAddress,
+			"",
+			inputFieldWithd,
+			tview.InputFieldMaxLength(bitcoinMaxAddressLength),
+			nil,
+		).
+		AddButton("Send", func() {
+			defer hide()
+
+			txID := form.GetFormItemByLabel(labelTxID).(*tview.TextView).GetText(false)
+			vout, _ := form.GetFormItemByLabel(labelOutput).(*tview.DropDown).GetCurrentOption()
+			feeRate := form.GetFormItemByLabel(labelFeeRate).(*tview.InputField).GetText()
+			addr := form.GetFormItemByLabel(labelDestinationAddress).(*tview.InputField).GetText()
+
+			nodeID := nodesList.GetCurrentItem()
+
+			childTxID, err := actionsHandler.childPaysForParent(
+				nodeID,
+				txID,
+				vout,
+				feeRate,
+				addr,
+			)
+			if err != nil {
+				output.AddError(fmt.Sprintf(
+					"child pays for parent tx %q output %d, from node %d: %s",
+					txID,
+					vout,
+					nodeID,
+					err,
+				))
+				return
+			}
+
+			output.AddSuccess(fmt.Sprintf(
+				"sent child tx %q paying for parent tx %q from node %d",
+				childTxID,
+				txID,
+				nodeID,
+			))
+		}).
+		AddButton("Cancel", hide).
+		SetCancelFunc(hide).
+		SetBorder(true).
+		SetTitle("Child Pays For Parent")
+
+	return &childPaysForParentForm{
+		Form: form,
+	}
+}
+
+// formHeight returns the height of a bordered form, every item takes a line
+// followed by a padding line, plus the buttons line.
+func formHeight(form *tview.Form) int {
+	const (
+		itemHeight = 2
+		extraLines = 3 // buttons and border lines
+	)
+
+	return form.GetFormItemCount()*itemHeight + extraLines
+}
+
 func hideForm(appPages *tview.Pages, form *tview.Form) func() {
 	return func() {
 		appPages.SwitchToPage("background")
@@ -704,6 +1037,8 @@ func hideForm(appPages *tview.Pages, form *tview.Form) func() {
 				formItem.SetCurrentOption(0)
 			case *tview.InputField:
 				formItem.SetText("")
+			case *tview.Checkbox:
+				formItem.SetChecked(false)
 			}
 		}
 	}
@@ -745,27 +1080,34 @@ func newAppPages(
 	nodeActionsList *nodeActionsList,
 	appFlex *appFlex,
 ) *appPages {
-	const (
-		width  = 100
-		height = 13
-	)
+	const width = 100
 
 	pages.
 		AddPage("background", appFlex, true, true).
 		AddPage("mineBlocksForm", centeredForm(
 			nodeActionsList.mineBlocksForm,
 			width,
-			height,
+			formHeight(nodeActionsList.mineBlocksForm.Form),
 		), true, false).
 		AddPage("sendBitcoinForm", centeredForm(
 			nodeActionsList.sendBitcoinForm,
 			width,
-			height,
+			formHeight(nodeActionsList.sendBitcoinForm.Form),
 		), true, false).
 		AddPage("replaceByFeeDrainToAddressForm", centeredForm(
 			nodeActionsList.rbfDrainToAddress,
 			width,
-			height,
+			formHeight(nodeActionsList.rbfDrainToAddress.Form),
+		), true, false).
+		AddPage("bumpFeeForm", centeredForm(
+			nodeActionsList.bumpFee,
+			width,
+			formHeight(nodeActionsList.bumpFee.Form),
+		), true, false).
+		AddPage("childPaysForParentForm", centeredForm(
+			nodeActionsList.cpfp,
+			width,
+			formHeight(nodeActionsList.cpfp.Form),
 		), true, false)
 
 	return &appPages{
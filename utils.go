@@ -19,6 +19,12 @@ func generateRPCPasswordSalt(size uint8) (string, error) {
 	return hex.EncodeToString(salt), nil
 }
 
+func generateNetworkID() (string, error) {
+	const networkIDSize = 4
+
+	return generateRPCPasswordSalt(networkIDSize)
+}
+
 func rpcPasswordToHMAC(salt, password string) string {
 	h := hmac.New(sha256.New, []byte(salt))
 
@@ -65,7 +71,7 @@ func ReplaceTransactionDrainToAddress(
 	newFee := 2 * fee
 	newAmount := totalInputs - newFee
 
-	hash, err := client.SendCustomTransaction(ctx, tx.Vin, map[string]float64{address: newAmount})
+	hash, err := client.SendCustomTransaction(ctx, tx.Vin, map[string]Amount{address: newAmount}, 0)
 	if err != nil {
 		return "", fmt.Errorf("send custom transaction: %w", err)
 	}
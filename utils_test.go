@@ -17,6 +17,7 @@ func newPrivateNetworkStartSuccessRPCClientFactory(
 ) *mock.RPCClientFactory {
 	return &mock.RPCClientFactory{
 		NewRPCClientFunc: func(
+			_ context.Context,
 			hostRPCPort string,
 			rpcUser string,
 			rpcPass string,
@@ -33,6 +34,7 @@ func newRPCClientFactoryWithDetachedNode(
 ) *mock.RPCClientFactory {
 	return &mock.RPCClientFactory{
 		NewRPCClientFunc: func(
+			_ context.Context,
 			hostRPCPort string,
 			rpcUser string,
 			rpcPass string,
@@ -56,6 +58,9 @@ func newPrivateNetworkStartSuccessDockerService(
 		) ([]privatebtc.NodeHandler, error) {
 			return containers, nil
 		},
+		RemoveNetworkFunc: func(context.Context, string) error {
+			return nil
+		},
 	}
 }
 
@@ -0,0 +1,113 @@
+package privatebtc
+
+import (
+	"context"
+	"fmt"
+
+	"golang.org/x/exp/maps"
+)
+
+// UTXO is an unspent transaction output of a node wallet.
+type UTXO struct {
+	TxID          string
+	Vout          uint32
+	Address       string
+	Label         string
+	Amount        Amount
+	Confirmations int
+	// Spendable reports whether the wallet has the private keys to spend the output.
+	Spendable bool
+	// Safe reports whether the output is considered safe to spend, unconfirmed
+	// outputs received from outside the wallet are not.
+	Safe bool
+}
+
+// Outpoint returns the outpoint of the UTXO, to be used as a transaction input.
+func (u UTXO) Outpoint() TransactionVin {
+	return TransactionVin{TxID: u.TxID, Vout: u.Vout}
+}
+
+// UTXOFilter filters the UTXOs returned by RPCClient.ListUnspent.
+// The zero value matches every UTXO, including the unconfirmed ones.
+type UTXOFilter struct {
+	// MinConf is the minimum number of confirmations.
+	MinConf int
+	// MaxConf is the maximum number of confirmations, there is no maximum when zero.
+	MaxConf int
+	// Addresses are the addresses the UTXOs are sent to, every address matches
+	// when empty.
+	Addresses []string
+}
+
+// CoinControlTransaction builds a transaction spending only the selected wallet
+// UTXOs. The node computes the change, sent back to a wallet address, and the
+// fee, paid as configured by the send options.
+type CoinControlTransaction struct {
+	client  RPCClient
+	inputs  []TransactionVin
+	outputs map[string]Amount
+	opts    SendOptions
+}
+
+// NewCoinControlTransaction creates a coin controlled transaction funded by the
+// wallet of the given client.
+func NewCoinControlTransaction(client RPCClient) *CoinControlTransaction {
+	return &CoinControlTransaction{
+		client:  client,
+		outputs: map[string]Amount{},
+	}
+}
+
+// AddInputs selects the given UTXOs as transaction inputs.
+func (t *CoinControlTransaction) AddInputs(utxos ...UTXO) *CoinControlTransaction {
+	for _, utxo := range utxos {
+		t.inputs = append(t.inputs, utxo.Outpoint())
+	}
+
+	return t
+}
+
+// AddOutput pays the given amount to the given address, the amounts paid to the
+// same address are summed up.
+func (t *CoinControlTransaction) AddOutput(address string, amount Amount) *CoinControlTransaction {
+	t.outputs[address] += amount
+
+	return t
+}
+
+// Options sets the fee rate, the change address and the other send options.
+func (t *CoinControlTransaction) Options(opts SendOptions) *CoinControlTransaction {
+	t.opts = opts
+
+	return t
+}
+
+// Fund returns the unsigned PSBT spending the selected inputs, with the change
+// output added by the wallet.
+func (t *CoinControlTransaction) Fund(ctx context.Context) (*FundedPSBT, error) {
+	if len(t.inputs) == 0 {
+		return nil, ErrNoCoinControlInputs
+	}
+
+	funded, err := t.client.WalletCreateFundedPSBT(ctx, t.inputs, maps.Clone(t.outputs), t.opts)
+	if err != nil {
+		return nil, fmt.Errorf("wallet create funded psbt: %w", err)
+	}
+
+	return funded, nil
+}
+
+// Send funds, signs and broadcasts the transaction.
+func (t *CoinControlTransaction) Send(ctx context.Context) (txHash string, _ error) {
+	funded, err := t.Fund(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	signed, err := t.client.WalletProcessPSBT(ctx, funded.PSBT, true)
+	if err != nil {
+		return "", fmt.Errorf("wallet process psbt: %w", err)
+	}
+
+	return finalizeAndBroadcastPSBT(ctx, t.client, signed.PSBT)
+}
@@ -0,0 +1,114 @@
+package privatebtc_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/adrianbrad/privatebtc"
+	"github.com/adrianbrad/privatebtc/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCoinControlTransaction(t *testing.T) {
+	t.Parallel()
+
+	errFund := errors.New("fund error")
+
+	utxos := []privatebtc.UTXO{
+		{TxID: "tx1", Vout: 0, Amount: privatebtc.BTC},
+		{TxID: "tx2", Vout: 3, Amount: privatebtc.BTC / 2},
+	}
+
+	tests := map[string]struct {
+		utxos          []privatebtc.UTXO
+		fundErr        error
+		expectedHash   string
+		expectedInputs []privatebtc.TransactionVin
+		expectedError  error
+	}{
+		"Success": {
+			utxos:        utxos,
+			expectedHash: "txhash",
+			expectedInputs: []privatebtc.TransactionVin{
+				{TxID: "tx1", Vout: 0},
+				{TxID: "tx2", Vout: 3},
+			},
+		},
+		"NoInputs": {
+			expectedError: privatebtc.ErrNoCoinControlInputs,
+		},
+		"FundError": {
+			utxos:         utxos,
+			fundErr:       errFund,
+			expectedError: errFund,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := require.New(t)
+
+			var sent []string
+
+			client := &mock.RPCClient{
+				WalletCreateFundedPSBTFunc: func(
+					_ context.Context,
+					inputs []privatebtc.TransactionVin,
+					amounts map[string]privatebtc.Amount,
+					opts privatebtc.SendOptions,
+				) (*privatebtc.FundedPSBT, error) {
+					if test.fundErr != nil {
+						return nil, test.fundErr
+					}
+
+					req.Equal(test.expectedInputs, inputs)
+					req.Equal(map[string]privatebtc.Amount{
+						"addr1": privatebtc.BTC / 2,
+						"addr2": privatebtc.BTC / 10,
+					}, amounts)
+					req.Equal(privatebtc.SendOptions{FeeRate: 10, ChangeAddress: "change"}, opts)
+
+					return &privatebtc.FundedPSBT{PSBT: "psbt", ChangePos: 2}, nil
+				},
+				WalletProcessPSBTFunc: func(_ context.Context, psbt string, sign bool) (*privatebtc.ProcessedPSBT, error) {
+					req.True(sign)
+
+					return &privatebtc.ProcessedPSBT{PSBT: psbt + "_signed", Complete: true}, nil
+				},
+				FinalizePSBTFunc: func(_ context.Context, psbt string) (*privatebtc.FinalizedPSBT, error) {
+					req.Equal("psbt_signed", psbt)
+
+					return &privatebtc.FinalizedPSBT{Hex: "txhex", Complete: true}, nil
+				},
+				SendRawTransactionFunc: func(_ context.Context, txHex string) (string, error) {
+					sent = append(sent, txHex)
+
+					return "txhash", nil
+				},
+			}
+
+			txHash, err := privatebtc.NewCoinControlTransaction(client).
+				AddInputs(test.utxos...).
+				AddOutput("addr1", privatebtc.BTC/4).
+				AddOutput("addr2", privatebtc.BTC/10).
+				AddOutput("addr1", privatebtc.BTC/4).
+				Options(privatebtc.SendOptions{FeeRate: 10, ChangeAddress: "change"}).
+				Send(context.Background())
+			req.ErrorIs(err, test.expectedError)
+			req.Equal(test.expectedHash, txHash)
+
+			if test.expectedError != nil {
+				req.Empty(sent)
+
+				return
+			}
+
+			req.Equal([]string{"txhex"}, sent)
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package privatebtc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// VersionMatrixResult is the outcome of running a test against a bitcoin client version.
+type VersionMatrixResult struct {
+	Version string
+	// Err is nil if the test passed.
+	Err error
+}
+
+// Passed reports whether the test passed for the version.
+func (r VersionMatrixResult) Passed() bool {
+	return r.Err == nil
+}
+
+// VersionMatrixResults holds the results of a version matrix run, in the order of
+// the versions.
+type VersionMatrixResults []VersionMatrixResult
+
+// Failed returns the versions for which the test failed.
+func (r VersionMatrixResults) Failed() []string {
+	var failed []string
+
+	for i := range r {
+		if !r[i].Passed() {
+			failed = append(failed, r[i].Version)
+		}
+	}
+
+	return failed
+}
+
+// Err joins the errors of every failed version, nil if the test passed for every version.
+func (r VersionMatrixResults) Err() error {
+	var errs error
+
+	for i := range r {
+		if !r[i].Passed() {
+			errs = errors.Join(errs, fmt.Errorf("version %s: %w", r[i].Version, r[i].Err))
+		}
+	}
+
+	return errs
+}
+
+// RunVersionMatrix runs the test function against every given bitcoin client version.
+// For every version a private network is created using newNetwork, which receives
+// the version and is expected to pass it to WithBitcoinClientVersion.
+// The network is started before the test runs and closed afterwards.
+// The versions are run sequentially, one network at a time, a failure does not stop
+// the remaining versions from running.
+func RunVersionMatrix(
+	ctx context.Context,
+	versions []string,
+	newNetwork func(version string) (*PrivateNetwork, error),
+	test func(ctx context.Context, pn *PrivateNetwork) error,
+) VersionMatrixResults {
+	results := make(VersionMatrixResults, len(versions))
+
+	for i, version := range versions {
+		results[i] = VersionMatrixResult{
+			Version: version,
+			Err:     runVersion(ctx, version, newNetwork, test),
+		}
+	}
+
+	return results
+}
+
+func runVersion(
+	ctx context.Context,
+	version string,
+	newNetwork func(version string) (*PrivateNetwork, error),
+	test func(ctx context.Context, pn *PrivateNetwork) error,
+) (err error) {
+	pn, err := newNetwork(version)
+	if err != nil {
+		return fmt.Errorf("new private network: %w", err)
+	}
+
+	defer func() {
+		if closeErr := pn.Close(); closeErr != nil {
+			err = errors.Join(err, fmt.Errorf("close private network: %w", closeErr))
+		}
+	}()
+
+	pn.logger.Info("🧪⌛ Running test against bitcoin client version", "version", version)
+
+	if err := pn.Start(ctx); err != nil {
+		return fmt.Errorf("start private network: %w", err)
+	}
+
+	if err := test(ctx, pn); err != nil {
+		return fmt.Errorf("test: %w", err)
+	}
+
+	pn.logger.Info("🧪✅ Test passed against bitcoin client version", "version", version)
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package privatebtc_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/adrianbrad/privatebtc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunVersionMatrix(t *testing.T) {
+	t.Parallel()
+
+	req := require.New(t)
+
+	var requestedVersions []string
+
+	newNetwork := func(version string) (*privatebtc.PrivateNetwork, error) {
+		mocks := newMockNodes(nil)
+
+		nodeService := mocks.namedNodeService()
+		createNodes := nodeService.CreateNodesFunc
+
+		nodeService.CreateNodesFunc = func(
+			ctx context.Context,
+			nodeRequests []privatebtc.CreateNodeRequest,
+		) ([]privatebtc.NodeHandler, error) {
+			for _, nodeReq := range nodeRequests {
+				requestedVersions = append(requestedVersions, nodeReq.BitcoinClientVersion)
+			}
+
+			return createNodes(ctx, nodeRequests)
+		}
+
+		return privatebtc.NewPrivateNetwork(
+			nodeService,
+			mocks.rpcClientFactory(),
+			2,
+			privatebtc.WithBitcoinClientVersion(version),
+		)
+	}
+
+	var testedVersions []string
+
+	results := privatebtc.RunVersionMatrix(
+		context.Background(),
+		[]string{"24.0", "25.0", "26.0"},
+		newNetwork,
+		func(_ context.Context, pn *privatebtc.PrivateNetwork) error {
+			version := requestedVersions[len(requestedVersions)-1]
+
+			testedVersions = append(testedVersions, version)
+
+			if version == "25.0" {
+				return assert.AnError
+			}
+
+			req.Len(pn.Nodes(), 2)
+
+			return nil
+		},
+	)
+
+	req.Equal([]string{"24.0", "24.0", "25.0", "25.0", "26.0", "26.0"}, requestedVersions)
+	req.Equal([]string{"24.0", "25.0", "26.0"}, testedVersions)
+
+	req.Len(results, 3)
+	req.True(results[0].Passed())
+	req.False(results[1].Passed())
+	req.True(results[2].Passed())
+
+	req.Equal([]string{"25.0"}, results.Failed())
+	req.ErrorIs(results.Err(), assert.AnError)
+	req.ErrorContains(results.Err(), "version 25.0")
+}